@@ -0,0 +1,71 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (C) 2020-2023 Daniel Bourdrez. All Rights Reserved.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree or at
+// https://spdx.org/licenses/MIT.html
+
+package crypto
+
+// AccumulateElements folds the elements into a single accumulator element, starting from the identity element, with
+// acc = acc + H(acc || e) * Base for each element e, where H is ChallengeFromElements with the DST. The accumulator is
+// deterministic and depends on the order of the elements, like a hash chain. Note that its discrete logarithm, the sum
+// of the hashes, is publicly computable: it therefore neither hides the elements nor allows membership proofs. An
+// empty list yields the identity element. The DST must not be empty or nil, and is recommended to be longer than 16
+// bytes.
+func (g Group) AccumulateElements(elements []*Element, dst []byte) *Element {
+	checkDST(dst)
+
+	acc := g.NewElement()
+	for _, e := range elements {
+		h := g.ChallengeFromElements(dst, acc, e)
+		acc.Add(g.Base().Multiply(h))
+	}
+
+	return acc
+}
+
+// ScalarAccumulator maintains a running sum of scalars in place, without allocating a new scalar for each addition.
+// Note that the scalars of the NIST and Secp256k1 groups still allocate temporaries within each addition. A
+// ScalarAccumulator is not safe for concurrent use by multiple goroutines.
+type ScalarAccumulator struct {
+	sum *Scalar
+}
+
+// NewScalarAccumulator returns a new ScalarAccumulator for the group, with a sum of 0.
+func (g Group) NewScalarAccumulator() *ScalarAccumulator {
+	return &ScalarAccumulator{sum: g.NewScalar()}
+}
+
+// Add adds the scalar to the running sum. A nil scalar is ignored.
+func (a *ScalarAccumulator) Add(s *Scalar) {
+	a.sum.Add(s)
+}
+
+// Sum returns a copy of the running sum, which is not affected by subsequent additions.
+func (a *ScalarAccumulator) Sum() *Scalar {
+	return a.sum.Copy()
+}
+
+// SumScalars returns the sum of the scalars, in a single new scalar. Nil scalars are ignored, and an empty list sums to
+// 0. It panics if a scalar is of another group.
+func (g Group) SumScalars(scalars ...*Scalar) *Scalar {
+	sum := g.NewScalar()
+	for _, s := range scalars {
+		sum.Add(s)
+	}
+
+	return sum
+}
+
+// ProductScalars returns the product of the scalars, in a single new scalar. As for Multiply, nil scalars are treated
+// as 0, and an empty list yields 1. It panics if a scalar is of another group.
+func (g Group) ProductScalars(scalars ...*Scalar) *Scalar {
+	product := g.NewScalar().One()
+	for _, s := range scalars {
+		product.Multiply(s)
+	}
+
+	return product
+}
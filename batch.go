@@ -0,0 +1,101 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (C) 2020-2023 Daniel Bourdrez. All Rights Reserved.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree or at
+// https://spdx.org/licenses/MIT.html
+
+package crypto
+
+import (
+	"crypto/subtle"
+	"errors"
+	"fmt"
+
+	"github.com/bytemare/crypto/internal"
+)
+
+var errInvalidPeer = errors.New("invalid peer public key")
+
+// SumIsIdentity returns whether the sum of the elements is the identity element, using a single accumulator. Nil
+// elements are treated as the identity, and an empty list sums to the identity.
+func (g Group) SumIsIdentity(elements []*Element) bool {
+	sum := g.NewElement()
+	for _, e := range elements {
+		sum.Add(e)
+	}
+
+	return sum.IsIdentity()
+}
+
+// ElementsEqual returns 1 if a and b have the same length and a[i] equals b[i] for every i, and 0 otherwise. The
+// elements are compared pairwise in the given order, so this is not a set equality, and is meant for public vectors of
+// the same length, e.g. lists of public keys. The canonical encodings of all pairs are compared with
+// subtle.ConstantTimeCompare without early exit, so the timing doesn't depend on where the vectors first differ. The
+// lengths and the positions of nil elements, which never compare equal, are not secret.
+func (g Group) ElementsEqual(a, b []*Element) int {
+	if len(a) != len(b) {
+		return 0
+	}
+
+	res := 1
+
+	for i := range a {
+		if a[i] == nil || b[i] == nil {
+			res = 0
+			continue
+		}
+
+		res &= subtle.ConstantTimeCompare(a[i].Encode(), b[i].Encode())
+	}
+
+	return res
+}
+
+// multiScalarMult returns the sum of the products of the scalars with the elements, which must have the same length.
+// Nil elements and scalars are treated as the identity and zero.
+func (g Group) multiScalarMult(scalars []*Scalar, elements []*Element) *Element {
+	sum := g.NewElement()
+	for i, e := range elements {
+		if e == nil {
+			continue
+		}
+
+		sum.Add(e.Copy().Multiply(scalars[i]))
+	}
+
+	return sum
+}
+
+// NegateAll sets each element to its negation, in place, by calling Negate on each of them. Nil elements are ignored.
+func (g Group) NegateAll(elements []*Element) {
+	for _, e := range elements {
+		if e != nil {
+			e.Negate()
+		}
+	}
+}
+
+// ECDHBatch returns the products of the private scalar with each of the peers' public keys, in the same order. Each
+// public key is first checked with IsValid, which rejects the identity, and the low-order points of Edwards25519. An
+// error is returned if the private scalar is nil or zero, or identifying the first invalid public key, in which case
+// no shared secret is returned.
+func (g Group) ECDHBatch(priv *Scalar, peers []*Element) ([]*Element, error) {
+	if priv == nil || priv.IsZero() {
+		return nil, fmt.Errorf("ECDH batch: %w", internal.ErrParamNilScalar)
+	}
+
+	for i, p := range peers {
+		if p == nil || !p.IsValid() {
+			return nil, fmt.Errorf("ECDH batch: peer %d: %w", i, errInvalidPeer)
+		}
+	}
+
+	shared := make([]*Element, len(peers))
+	for i, p := range peers {
+		shared[i] = p.Copy().Multiply(priv)
+	}
+
+	return shared, nil
+}
@@ -0,0 +1,52 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (C) 2020-2023 Daniel Bourdrez. All Rights Reserved.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree or at
+// https://spdx.org/licenses/MIT.html
+
+package crypto
+
+import "encoding/binary"
+
+// ChallengeFromElements returns a challenge scalar bound to the ordered list of elements, as used in sigma protocols.
+// The canonical encodings of the elements are each prefixed with their 2-byte big-endian length, concatenated, and
+// hashed to a scalar with HashToScalar and the DST. The DST must not be empty or nil, and is recommended to be longer
+// than 16 bytes.
+func (g Group) ChallengeFromElements(dst []byte, elements ...*Element) *Scalar {
+	var transcript []byte
+
+	for _, e := range elements {
+		enc := e.Encode()
+		transcript = binary.BigEndian.AppendUint16(transcript, uint16(len(enc)))
+		transcript = append(transcript, enc...)
+	}
+
+	return g.HashToScalar(transcript, dst)
+}
+
+// ChallengeScalar returns the Schnorr-style challenge H(Base || R || pub || msg) hashed to a scalar with HashToScalar
+// and the DST, explicitly binding the group's base point. The DST must not be empty or nil, and is recommended to be
+// longer than 16 bytes.
+func (g Group) ChallengeScalar(R, pub *Element, msg, dst []byte) *Scalar {
+	base := g.Base().Encode()
+	r := R.Encode()
+	p := pub.Encode()
+
+	input := make([]byte, 0, len(base)+len(r)+len(p)+len(msg))
+	input = append(input, base...)
+	input = append(input, r...)
+	input = append(input, p...)
+	input = append(input, msg...)
+
+	return g.HashToScalar(input, dst)
+}
+
+// Commit returns a fresh random non-zero nonce r from crypto/rand and its commitment R = r * Base, as used in the first
+// move of Schnorr-style proofs and signatures. The response is then typically z = r + c * x for the challenge c. Each
+// call returns a new nonce, which must never be reused across proofs, as this leaks the secret x.
+func (g Group) Commit() (r *Scalar, R *Element) {
+	r = g.NewScalar().Random()
+	return r, g.Base().Multiply(r)
+}
@@ -0,0 +1,51 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (C) 2020-2023 Daniel Bourdrez. All Rights Reserved.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree or at
+// https://spdx.org/licenses/MIT.html
+
+package crypto
+
+// cofactorLog returns the base-2 logarithm of the group's cofactor, i.e. the number of doublings that multiply an
+// element by the cofactor.
+func (g Group) cofactorLog() int {
+	if g == Edwards25519Sha512 {
+		return 3
+	}
+
+	return 0
+}
+
+// CofactoredEqual returns 1 if a and b multiplied by the cofactor are equal, and 0 otherwise, in constant time. For
+// Edwards25519, this compares [8]a and [8]b as in the cofactored verification equation of RFC 8032, and therefore
+// accepts elements that differ by a low-order component. For the other groups, the cofactor is 1 and this is the same
+// as Equal.
+func (g Group) CofactoredEqual(a, b *Element) int {
+	return a.Copy().DoubleN(g.cofactorLog()).Equal(b.Copy().DoubleN(g.cofactorLog()))
+}
+
+// SubgroupMembershipWitness returns whether e is in the prime-order subgroup, and if so a witness of it that is cheaper
+// to verify than multiplying e by the order. This is only meaningful for Edwards25519, the only group with a cofactor:
+// the witness is the encoding of Q = [8^-1]e, and since [8]Q is always in the prime-order subgroup, verifying
+// that [8]Q == e, i.e. with three doublings, proves that e is in the subgroup. For the other groups, all elements are
+// in the prime-order group, and the witness is empty. A nil element yields no witness and false.
+func (g Group) SubgroupMembershipWitness(e *Element) ([]byte, bool) {
+	if e == nil {
+		return nil, false
+	}
+
+	if g.cofactorLog() == 0 {
+		return []byte{}, true
+	}
+
+	cofactorInverse := g.NewScalar().SetUInt64(1 << g.cofactorLog()).Invert()
+	q := e.Copy().Multiply(cofactorInverse)
+
+	if q.Copy().DoubleN(g.cofactorLog()).Equal(e) != 1 {
+		return nil, false
+	}
+
+	return q.Encode(), true
+}
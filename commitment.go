@@ -0,0 +1,35 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (C) 2020-2023 Daniel Bourdrez. All Rights Reserved.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree or at
+// https://spdx.org/licenses/MIT.html
+
+package crypto
+
+import "fmt"
+
+// VectorCommit returns the vector Pedersen commitment sum(a_i * G_i) + sum(b_i * H_i) + blind * B, as used in
+// Bulletproofs. a and G, and b and H, must have the same lengths, and an error is returned otherwise.
+func (g Group) VectorCommit(a, b []*Scalar, G, H []*Element, blind *Scalar, B *Element) (*Element, error) {
+	if len(a) != len(G) {
+		return nil, fmt.Errorf("vector commitment: %w (%d values for %d generators)", errLengths, len(a), len(G))
+	}
+
+	if len(b) != len(H) {
+		return nil, fmt.Errorf("vector commitment: %w (%d blinding values for %d generators)", errLengths, len(b), len(H))
+	}
+
+	scalars := make([]*Scalar, 0, len(a)+len(b)+1)
+	scalars = append(scalars, a...)
+	scalars = append(scalars, b...)
+	scalars = append(scalars, blind)
+
+	bases := make([]*Element, 0, len(G)+len(H)+1)
+	bases = append(bases, G...)
+	bases = append(bases, H...)
+	bases = append(bases, B)
+
+	return g.multiScalarMult(scalars, bases), nil
+}
@@ -0,0 +1,191 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (C) 2020-2023 Daniel Bourdrez. All Rights Reserved.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree or at
+// https://spdx.org/licenses/MIT.html
+
+package crypto
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+)
+
+var (
+	errLowOrder     = errors.New("element has low order")
+	errNonCanonical = errors.New("non-canonical element encoding")
+	errNotSubgroup  = errors.New("element is not in the prime-order subgroup")
+)
+
+var (
+	baseEncodingOnce     [maxID - 1]sync.Once
+	baseEncodings        [maxID - 1][]byte
+	identityEncodingOnce [maxID - 1]sync.Once
+	identityEncodings    [maxID - 1][]byte
+)
+
+// baseEncoding returns the encoding of the group's base point, which is computed only once.
+func (g Group) baseEncoding() []byte {
+	g.get()
+	baseEncodingOnce[g-1].Do(func() {
+		baseEncodings[g-1] = g.Base().Encode()
+	})
+
+	return baseEncodings[g-1]
+}
+
+// IdentityEncoding returns the encoding of the identity element, as returned by NewElement().Encode(). It is computed
+// only once, and a new copy is returned on each call, so it can be modified by the caller.
+func (g Group) IdentityEncoding() []byte {
+	g.get()
+	identityEncodingOnce[g-1].Do(func() {
+		identityEncodings[g-1] = g.NewElement().Encode()
+	})
+
+	return slices.Clone(identityEncodings[g-1])
+}
+
+// DecodeElementCT decodes b and returns the element and 1 on success, or the identity element and 0 on failure. Its
+// timing is balanced, but not constant-time: a failed decoding is followed by the full decoding of a valid encoding,
+// so that invalid inputs don't return earlier than valid ones, but take longer instead. The underlying decoding of some
+// groups rejects invalid inputs at different stages, and the length of the input is not hidden.
+func (g Group) DecodeElementCT(b []byte) (*Element, int) {
+	e := g.NewElement()
+	if err := e.Decode(b); err == nil {
+		return e, 1
+	}
+
+	_ = g.NewElement().Decode(g.baseEncoding())
+
+	return e.Identity(), 0
+}
+
+// DecodeError is returned by DecodeElements and DecodeScalars, and identifies the first input that failed to decode.
+type DecodeError struct {
+	err   error
+	kind  string
+	index int
+}
+
+// Error implements the error interface.
+func (e *DecodeError) Error() string {
+	return fmt.Sprintf("%s %d: %v", e.kind, e.index, e.err)
+}
+
+// Unwrap returns the underlying decoding error.
+func (e *DecodeError) Unwrap() error {
+	return e.err
+}
+
+// Index returns the index of the input that failed to decode.
+func (e *DecodeError) Index() int {
+	return e.index
+}
+
+// DecodeElements decodes all the encodings, and returns the elements in the same order, backed by a single slice. On
+// failure, it returns a *DecodeError identifying the first encoding that failed to decode.
+func (g Group) DecodeElements(encodings [][]byte) ([]*Element, error) {
+	backing := make([]Element, len(encodings))
+	elements := make([]*Element, len(encodings))
+
+	for i, enc := range encodings {
+		backing[i].Element = g.get().NewElement()
+		if err := backing[i].Decode(enc); err != nil {
+			return nil, &DecodeError{err: err, kind: "element", index: i}
+		}
+
+		elements[i] = &backing[i]
+	}
+
+	return elements, nil
+}
+
+// ValidateEncodings checks all the element encodings, and returns an error for each of them, in the same order, which
+// is nil if the encoding is valid. An encoding is valid if it has the right length, decodes to a point on the curve
+// other than the identity, and, for Edwards25519, that point is in the prime-order subgroup. Errors are of type
+// *DecodeError, and identify the index of the encoding.
+func (g Group) ValidateEncodings(encodings [][]byte) []error {
+	errs := make([]error, len(encodings))
+	e := g.NewElement()
+
+	for i, enc := range encodings {
+		switch err := e.Decode(enc); {
+		case err != nil:
+			errs[i] = &DecodeError{err: err, kind: "element", index: i}
+		case !e.IsValid():
+			errs[i] = &DecodeError{err: errNotSubgroup, kind: "element", index: i}
+		}
+	}
+
+	return errs
+}
+
+// DecodeScalars decodes all the encodings, and returns the scalars in the same order, backed by a single slice. On
+// failure, it returns a *DecodeError identifying the first encoding that failed to decode.
+func (g Group) DecodeScalars(encodings [][]byte) ([]*Scalar, error) {
+	backing := make([]Scalar, len(encodings))
+	scalars := make([]*Scalar, len(encodings))
+
+	for i, enc := range encodings {
+		backing[i].Scalar = g.get().NewScalar()
+		if err := backing[i].Decode(enc); err != nil {
+			return nil, &DecodeError{err: err, kind: "scalar", index: i}
+		}
+
+		scalars[i] = &backing[i]
+	}
+
+	return scalars, nil
+}
+
+// DecodePolicy selects the validation checks applied by DecodeElementWithPolicy on top of the decoding itself.
+type DecodePolicy struct {
+	// RejectIdentity rejects the encoding of the identity element. If false, the identity is accepted in the encoding
+	// returned by PointToString.
+	RejectIdentity bool
+
+	// RejectLowOrder rejects elements whose multiple by the cofactor is the identity, which includes the identity
+	// itself. This only matters for Edwards25519, as the other groups have prime order.
+	RejectLowOrder bool
+
+	// RequireCanonical rejects encodings that decode to a valid element but differ from the element's own encoding,
+	// like the non-reduced y-coordinates accepted by Edwards25519.
+	RequireCanonical bool
+}
+
+// DecodeElementWithPolicy decodes b and applies the checks selected by the policy, returning an error if any of them
+// fails.
+func (g Group) DecodeElementWithPolicy(b []byte, p DecodePolicy) (*Element, error) {
+	var (
+		e   *Element
+		err error
+	)
+
+	if p.RejectIdentity {
+		e = g.NewElement()
+		err = e.Decode(b)
+	} else {
+		e, err = g.StringToPoint(b)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("decode with policy: %w", err)
+	}
+
+	if p.RejectLowOrder {
+		if e.Copy().DoubleN(g.cofactorLog()).IsIdentity() {
+			return nil, fmt.Errorf("decode with policy: %w", errLowOrder)
+		}
+	}
+
+	if p.RequireCanonical && !bytes.Equal(g.PointToString(e), b) {
+		return nil, fmt.Errorf("decode with policy: %w", errNonCanonical)
+	}
+
+	return e, nil
+}
@@ -0,0 +1,55 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (C) 2020-2023 Daniel Bourdrez. All Rights Reserved.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree or at
+// https://spdx.org/licenses/MIT.html
+
+package crypto
+
+// ProveDLog returns P = x * Base() and a non-interactive Schnorr proof of knowledge of x, using the Fiat-Shamir
+// transform over ChallengeFromElements with the given DST. The proof is the concatenation of the encoded commitment and
+// response.
+// The DST must not be empty or nil, and is recommended to be longer than 16 bytes.
+func (g Group) ProveDLog(x *Scalar, dst []byte) (P *Element, proof []byte) {
+	P = g.Base().Multiply(x)
+	r := g.NewScalar().Random()
+	R := g.Base().Multiply(r)
+	c := g.ChallengeFromElements(dst, g.Base(), P, R)
+
+	// z = r + c*x
+	z := c.Multiply(x).Add(r)
+
+	proof = make([]byte, 0, g.ElementLength()+g.ScalarLength())
+	proof = append(proof, R.Encode()...)
+	proof = append(proof, z.Encode()...)
+
+	return P, proof
+}
+
+// VerifyDLog returns whether the proof is a valid proof of knowledge of the discrete logarithm of P, as produced by
+// ProveDLog with the same DST.
+func (g Group) VerifyDLog(P *Element, proof, dst []byte) bool {
+	if P == nil || len(proof) != g.ElementLength()+g.ScalarLength() {
+		return false
+	}
+
+	R := g.NewElement()
+	if err := R.Decode(proof[:g.ElementLength()]); err != nil {
+		return false
+	}
+
+	z := g.NewScalar()
+	if err := z.Decode(proof[g.ElementLength():]); err != nil {
+		return false
+	}
+
+	c := g.ChallengeFromElements(dst, g.Base(), P, R)
+
+	// z*G == R + c*P
+	left := g.Base().Multiply(z)
+	right := P.Copy().Multiply(c).Add(R)
+
+	return left.Equal(right) == 1
+}
@@ -10,6 +10,7 @@
 package crypto
 
 import (
+	"crypto/subtle"
 	"fmt"
 	"strings"
 
@@ -47,18 +48,67 @@ func (e *Element) Add(element *Element) *Element {
 	return e
 }
 
+// AddMany sets the receiver to the sum of the receiver and all the inputs, and returns the receiver. It is equivalent
+// to calling Add for each input, but the group of each input is checked once upfront, before nil inputs and identity
+// elements are skipped. Skipping the identity elements is not constant-time, and reveals which inputs are the
+// identity. It panics if an input is from another group.
+func (e *Element) AddMany(elements ...*Element) *Element {
+	suite := e.Element.Ciphersuite()
+
+	for _, element := range elements {
+		if element == nil {
+			continue
+		}
+
+		if element.Element.Ciphersuite() != suite {
+			panic(internal.ErrCastElement)
+		}
+
+		if element.Element.IsIdentity() {
+			continue
+		}
+
+		e.Element.Add(element.Element)
+	}
+
+	return e
+}
+
 // Double sets the receiver to its double, and returns it.
 func (e *Element) Double() *Element {
 	e.Element.Double()
 	return e
 }
 
+// DoubleN sets the receiver to its multiplication by 2^n, i.e. doubles it n times, and returns it. It is a no-op if n
+// is not positive.
+func (e *Element) DoubleN(n int) *Element {
+	e.Element.DoubleN(n)
+	return e
+}
+
 // Negate sets the receiver to its negation, and returns it.
 func (e *Element) Negate() *Element {
 	e.Element.Negate()
 	return e
 }
 
+// ClearCofactor sets the receiver to its multiplication by the group's cofactor, and returns it. The result is in
+// the prime-order subgroup. Only Edwards25519 has a cofactor, of 8, and this is a no-op for the other groups. For
+// Ristretto255, this is unnecessary since the encoding already enforces the prime-order quotient group.
+func (e *Element) ClearCofactor() *Element {
+	e.Element.ClearCofactor()
+	return e
+}
+
+// IsInPrimeOrderSubgroup returns whether the element is in the prime-order subgroup. Only Edwards25519 has elements
+// outside of it, those with a low-order component, which are rejected by checking that the multiplication by the group
+// order yields the identity element. This is recommended after decoding untrusted Edwards25519 input. It always returns
+// true for the other groups, which have prime order.
+func (e *Element) IsInPrimeOrderSubgroup() bool {
+	return e.Element.IsInPrimeOrderSubgroup()
+}
+
 // Subtract subtracts the input from the receiver, and returns the receiver.
 func (e *Element) Subtract(element *Element) *Element {
 	if element == nil {
@@ -82,6 +132,20 @@ func (e *Element) Multiply(scalar *Scalar) *Element {
 	return e
 }
 
+// MultiplyVartime sets the receiver to the scalar multiplication of the receiver with the given Scalar, and returns
+// it. It is faster than Multiply, but is not constant-time: it must only be used with public scalars, e.g. when
+// verifying signatures.
+func (e *Element) MultiplyVartime(scalar *Scalar) *Element {
+	if scalar == nil {
+		e.Element.Identity()
+		return e
+	}
+
+	e.Element.MultiplyVartime(scalar.Scalar)
+
+	return e
+}
+
 // Equal returns 1 if the elements are equivalent, and 0 otherwise.
 func (e *Element) Equal(element *Element) int {
 	if element == nil {
@@ -91,11 +155,38 @@ func (e *Element) Equal(element *Element) int {
 	return e.Element.Equal(element.Element)
 }
 
+// EqualStrict returns 1 if the elements are of the same group and equivalent, and 0 otherwise. Contrary to Equal, it
+// doesn't panic if the elements are of different groups. Whether the groups match is not secret and is not checked in
+// constant time, but the comparison of elements of the same group is.
+func (e *Element) EqualStrict(element *Element) int {
+	if element == nil || e.Element.Ciphersuite() != element.Element.Ciphersuite() {
+		return 0
+	}
+
+	return e.Element.Equal(element.Element)
+}
+
 // IsIdentity returns whether the Element is the point at infinity of the Group's underlying curve.
 func (e *Element) IsIdentity() bool {
 	return e.Element.IsIdentity()
 }
 
+// IsValid returns whether the element is a non-identity element of the prime-order group, i.e. whether it is on the
+// curve and, for groups with a cofactor like Edwards25519, in the prime-order subgroup. Like Decode, it rejects the
+// identity element, but contrary to Decode, it also rejects Edwards25519 low-order points and points with a low-order
+// component.
+func (e *Element) IsValid() bool {
+	return e.Element.IsValid()
+}
+
+// HasPrimeOrder returns whether the element has exactly the prime order of the group, i.e. whether it is not the
+// identity and, for Edwards25519, whether its multiplication by the order is the identity, which rejects low-order and
+// mixed-order points. For the other groups, every element but the identity has prime order. This is the same check as
+// IsValid, without the re-validation of the curve equation, and gives a portable check of received public keys.
+func (e *Element) HasPrimeOrder() bool {
+	return !e.Element.IsIdentity() && e.Element.IsInPrimeOrderSubgroup()
+}
+
 // Set sets the receiver to the argument, and returns the receiver.
 func (e *Element) Set(element *Element) *Element {
 	if element == nil {
@@ -109,6 +200,35 @@ func (e *Element) Set(element *Element) *Element {
 	return e
 }
 
+// CondSet sets the receiver to the argument if cond is 1, leaves it unchanged if cond is 0, and returns the receiver.
+// The selection is constant-time, which allows choosing between secret values based on a secret bit, with the caveat
+// that the Secp256k1 arithmetic is not constant-time itself. A nil element is treated as the identity element. The
+// behavior is undefined if cond is not 0 or 1.
+func (e *Element) CondSet(element *Element, cond int) *Element {
+	if element == nil {
+		element = e.Copy().Identity()
+	}
+
+	e.Element.CondSet(element.Element, cond)
+
+	return e
+}
+
+// SelectFrom sets the receiver to table[index] and returns it. Every entry of the table is visited, so that the
+// selection is constant-time with regard to the index, with the same caveat on Secp256k1 as for CondSet. The receiver
+// is set to the identity element if the index is out of range, and nil entries are treated as the identity element.
+func (e *Element) SelectFrom(table []*Element, index int) *Element {
+	e.Identity()
+
+	for i, t := range table {
+		// Compare both 32-bit halves, so that indexes beyond the int32 range don't alias a table entry.
+		d := uint64(i) ^ uint64(index)
+		e.CondSet(t, subtle.ConstantTimeEq(int32(d), 0)&subtle.ConstantTimeEq(int32(d>>32), 0))
+	}
+
+	return e
+}
+
 // Copy returns a copy of the receiver.
 func (e *Element) Copy() *Element {
 	return &Element{Element: e.Element.Copy()}
@@ -133,6 +253,40 @@ func (e *Element) Decode(data []byte) error {
 	return nil
 }
 
+// uncompressedElement is implemented by the elements of the groups that have a SEC1 uncompressed encoding.
+type uncompressedElement interface {
+	EncodeUncompressed() []byte
+	DecodeUncompressed(data []byte) error
+}
+
+// EncodeUncompressed returns the SEC1 uncompressed encoding of the element, i.e. 0x04 || X || Y, with the identity
+// element encoded as a zero-filled slice of the same length. Only the NIST and Secp256k1 groups support this, and this
+// function panics for the other groups, which have no meaningful uncompressed form.
+func (e *Element) EncodeUncompressed() []byte {
+	u, ok := e.Element.(uncompressedElement)
+	if !ok {
+		panic(errUnsupported)
+	}
+
+	return u.EncodeUncompressed()
+}
+
+// DecodeUncompressed sets the receiver to the decoding of the SEC1 uncompressed encoding, and returns an error on
+// failure. Like Decode, it rejects the identity element. Only the NIST and Secp256k1 groups support this, and an error
+// is returned for the other groups.
+func (e *Element) DecodeUncompressed(data []byte) error {
+	u, ok := e.Element.(uncompressedElement)
+	if !ok {
+		return fmt.Errorf("element DecodeUncompressed: %w", errUnsupported)
+	}
+
+	if err := u.DecodeUncompressed(data); err != nil {
+		return fmt.Errorf("element DecodeUncompressed: %w", err)
+	}
+
+	return nil
+}
+
 // Hex returns the fixed-sized hexadecimal encoding of e.
 func (e *Element) Hex() string {
 	return e.Element.Hex()
@@ -158,6 +312,17 @@ func (e *Element) UnmarshalJSON(data []byte) error {
 	return e.DecodeHex(j)
 }
 
+// MarshalText implements the encoding.TextMarshaler interface, and returns the hexadecimal encoding of the element.
+func (e *Element) MarshalText() ([]byte, error) {
+	return []byte(e.Hex()), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface, and sets e to the decoding of the hex encoded
+// element.
+func (e *Element) UnmarshalText(data []byte) error {
+	return e.DecodeHex(string(data))
+}
+
 // MarshalBinary returns the compressed byte encoding of the element.
 func (e *Element) MarshalBinary() ([]byte, error) {
 	return e.Element.Encode(), nil
@@ -0,0 +1,60 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (C) 2020-2023 Daniel Bourdrez. All Rights Reserved.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree or at
+// https://spdx.org/licenses/MIT.html
+
+package crypto
+
+import (
+	"math/big"
+
+	"github.com/bytemare/crypto/internal/field"
+)
+
+// ScalarFieldOps offers modular arithmetic on big.Int values modulo the order of the group's scalar field, for
+// computations that don't need the Scalar abstraction. The results are always reduced, but the operations are not
+// constant-time and must only be used with public values.
+type ScalarFieldOps interface {
+	// Add sets res to x + y modulo the order, and returns it.
+	Add(res, x, y *big.Int) *big.Int
+
+	// Mul sets res to x * y modulo the order, and returns it.
+	Mul(res, x, y *big.Int) *big.Int
+
+	// Inv sets res to the inverse of x modulo the order, and returns it. As for Scalar.Invert, the inverse of 0 is 0.
+	Inv(res, x *big.Int) *big.Int
+
+	// Exp sets res to x^n modulo the order, and returns it.
+	Exp(res, x, n *big.Int) *big.Int
+}
+
+type scalarField struct {
+	field.Field
+}
+
+// ScalarFieldOps returns modular arithmetic helpers over the group's scalar field.
+func (g Group) ScalarFieldOps() ScalarFieldOps {
+	return scalarField{field.NewField(g.OrderBigInt())}
+}
+
+func (f scalarField) Add(res, x, y *big.Int) *big.Int {
+	f.Field.Add(res, x, y)
+	return res
+}
+
+func (f scalarField) Mul(res, x, y *big.Int) *big.Int {
+	f.Field.Mul(res, x, y)
+	return res
+}
+
+func (f scalarField) Inv(res, x *big.Int) *big.Int {
+	f.Field.Inv(res, x)
+	return res
+}
+
+func (f scalarField) Exp(res, x, n *big.Int) *big.Int {
+	return f.Exponent(res, x, n)
+}
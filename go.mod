@@ -5,13 +5,13 @@ go 1.22.2
 require (
 	filippo.io/edwards25519 v1.1.0
 	filippo.io/nistec v0.0.3
+	github.com/bytemare/hash v0.3.0
 	github.com/bytemare/hash2curve v0.3.0
 	github.com/bytemare/secp256k1 v0.1.4
 	github.com/gtank/ristretto255 v0.1.2
 )
 
 require (
-	github.com/bytemare/hash v0.3.0 // indirect
 	golang.org/x/crypto v0.25.0 // indirect
 	golang.org/x/sys v0.22.0 // indirect
 )
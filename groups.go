@@ -14,8 +14,14 @@ package crypto
 
 import (
 	"crypto"
+	"encoding/binary"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
+	"math"
+	"math/big"
+	"slices"
 	"sync"
 
 	"github.com/bytemare/crypto/internal"
@@ -55,13 +61,41 @@ const (
 	dstfmt               = "%s-V%02d-CS%02d-%s"
 	minLength            = 0
 	recommendedMinLength = 16
+
+	randomElementApp         = "RandomElement"
+	randomElementInputLength = 64
+	fixedContextApp          = "HashToScalarFixedContext"
+
+	// maxXMDBlocks is the maximum number of hash outputs a single expand_message_xmd can produce.
+	maxXMDBlocks = 255
 )
 
 var (
-	once          [maxID - 1]sync.Once
-	groups        [maxID - 1]internal.Group
-	errInvalidID  = errors.New("invalid group identifier")
-	errZeroLenDST = errors.New("zero-length DST")
+	once           [maxID - 1]sync.Once
+	groups         [maxID - 1]internal.Group
+	generatorOnce  [maxID - 1]sync.Once
+	generators     [maxID - 1]*Element
+	orderOnce      [maxID - 1]sync.Once
+	orders         [maxID - 1]*big.Int
+	errInvalidID   = errors.New("invalid group identifier")
+	errNotImpl     = errors.New("group not yet implemented")
+	errZeroLenDST  = errors.New("zero-length DST")
+	errUnsupported = errors.New("operation not supported by this group")
+	errLengths     = errors.New("mismatching input lengths")
+	errInt64Range  = errors.New("scalar is out of the int64 range")
+	errHKDFHash    = errors.New("unsupported or unavailable hash function for HKDF")
+	errHKDFLength  = errors.New("invalid HKDF output length")
+	errObjGroup    = errors.New("objects are encoded for another group")
+	errObjLength   = errors.New("invalid length of encoded objects")
+	errDSTLength   = errors.New("DST component is longer than 65535 bytes")
+	errScalarField = errors.New("the groups have different scalar fields")
+	errSecLength   = errors.New("security length is lower than the group's minimum")
+	errWindow      = errors.New("window must be between 1 and 8")
+	errHexLength   = errors.New("invalid hex length")
+	errUniformLen  = errors.New("invalid uniform bytes length")
+	errSeedLength  = errors.New("invalid seed length")
+	errScalarCount = errors.New("too many scalars for a single expand_message_xmd")
+	errFieldElem   = errors.New("invalid field element encoding")
 )
 
 // Available reports whether the given Group is linked into the binary.
@@ -69,6 +103,25 @@ func (g Group) Available() bool {
 	return 0 < g && g < maxID && g != decaf448Shake256
 }
 
+// CheckAvailable returns nil if the given Group is linked into the binary, and otherwise an error explaining why not:
+// either the identifier is reserved for a group that is not yet implemented, or it is invalid.
+func (g Group) CheckAvailable() error {
+	switch {
+	case g == decaf448Shake256:
+		return errNotImpl
+	case !g.Available():
+		return errInvalidID
+	default:
+		return nil
+	}
+}
+
+// SameAs returns true only if both groups are available and identical, i.e. refer to the same curve and hash function.
+// It never panics, and can be used as a guard before operations that mix objects of both groups.
+func (g Group) SameAs(other Group) bool {
+	return g.Available() && other.Available() && g == other
+}
+
 func (g Group) get() internal.Group {
 	if !g.Available() {
 		panic(errInvalidID)
@@ -86,16 +139,212 @@ func (g Group) MakeDST(app string, version uint8) []byte {
 	return []byte(fmt.Sprintf(dstfmt, app, version, g, p.Ciphersuite()))
 }
 
+// MakeDSTEncode is like MakeDST, but uses the encode-to-curve (NU_) suite identifier returned by CiphersuiteNU, i.e.
+// <app>-V<version>-CS<id>-<encode-to-curve-ID>. Since Ristretto255 has no encode-to-curve suite, this is the same as
+// MakeDST for that group.
+func (g Group) MakeDSTEncode(app string, version uint8) []byte {
+	return []byte(fmt.Sprintf(dstfmt, app, version, g, g.CiphersuiteNU()))
+}
+
+// SubDST derives a domain separation tag for a sub-protocol from the root DST and the label path, in the form of
+// I2OSP(len(root), 2) || root || I2OSP(len(label), 2) || label || ..., for each label. Since every component is
+// length-prefixed, distinct roots or label paths always yield distinct DSTs. DSTs longer than 255 bytes are reduced as
+// specified in RFC 9380 when hashing. The root must not be empty or nil, and no component can be longer than 65535
+// bytes.
+func (g Group) SubDST(root []byte, labels ...string) []byte {
+	checkDST(root)
+
+	length := 2 + len(root)
+	for _, label := range labels {
+		length += 2 + len(label)
+	}
+
+	dst := make([]byte, 0, length)
+	dst = appendLengthPrefixed(dst, root)
+
+	for _, label := range labels {
+		dst = appendLengthPrefixed(dst, []byte(label))
+	}
+
+	return dst
+}
+
+func appendLengthPrefixed(dst, b []byte) []byte {
+	if len(b) > math.MaxUint16 {
+		panic(errDSTLength)
+	}
+
+	dst = binary.BigEndian.AppendUint16(dst, uint16(len(b)))
+
+	return append(dst, b...)
+}
+
 // String returns the hash-to-curve string identifier of the ciphersuite.
 func (g Group) String() string {
 	return g.get().Ciphersuite()
 }
 
+// CiphersuiteNU returns the encode-to-curve (NU_) string identifier of the ciphersuite, used by EncodeToGroup, e.g.
+// "P256_XMD:SHA-256_SSWU_NU_". Ristretto255 has no encode-to-curve suite, so this is the same as String.
+func (g Group) CiphersuiteNU() string {
+	return g.get().CiphersuiteNU()
+}
+
+// Suites returns the hash-to-curve (RO_) and encode-to-curve (NU_) suite identifiers of the group, used by HashToGroup
+// and EncodeToGroup, respectively. Ristretto255 has no encode-to-curve suite, so both are the same.
+func (g Group) Suites() (ro, nu string) {
+	p := g.get()
+	return p.Ciphersuite(), p.CiphersuiteNU()
+}
+
+// MarshalJSON marshals the group into its hash-to-curve suite identifier as a JSON string, and returns an error if the
+// group is not available.
+func (g Group) MarshalJSON() ([]byte, error) {
+	if err := g.CheckAvailable(); err != nil {
+		return nil, fmt.Errorf("group MarshalJSON: %w", err)
+	}
+
+	return []byte(fmt.Sprintf("%q", g.String())), nil
+}
+
+// UnmarshalJSON sets the group to the one identified by the hash-to-curve suite identifier in the JSON string, as
+// recognized by GroupFromString, and returns an error if the identifier is unknown.
+func (g *Group) UnmarshalJSON(data []byte) error {
+	var h2cID string
+	if err := json.Unmarshal(data, &h2cID); err != nil {
+		return fmt.Errorf("group UnmarshalJSON: %w", err)
+	}
+
+	id, err := GroupFromString(h2cID)
+	if err != nil {
+		return fmt.Errorf("group UnmarshalJSON: %w", err)
+	}
+
+	*g = id
+
+	return nil
+}
+
+// GroupFromString returns the group identified by the hash-to-curve suite identifier, either of its hash-to-curve (RO_)
+// or encode-to-curve (NU_) variant, e.g. "P256_XMD:SHA-256_SSWU_RO_" or "P256_XMD:SHA-256_SSWU_NU_" for P256Sha256. An
+// error is returned if the identifier is unknown. Ristretto255 only has a hash-to-curve identifier.
+func GroupFromString(h2cID string) (Group, error) {
+	switch h2cID {
+	case ristretto.H2C:
+		return Ristretto255Sha512, nil
+	case nist.H2CP256, nist.E2CP256:
+		return P256Sha256, nil
+	case nist.H2CP384, nist.E2CP384:
+		return P384Sha384, nil
+	case nist.H2CP521, nist.E2CP521:
+		return P521Sha512, nil
+	case edwards25519.H2C, edwards25519.E2C:
+		return Edwards25519Sha512, nil
+	case secp256k1.H2CSECP256K1, secp256k1.E2CSECP256K1:
+		return Secp256k1, nil
+	default:
+		return 0, errInvalidID
+	}
+}
+
 // NewScalar returns a new scalar set to 0.
 func (g Group) NewScalar() *Scalar {
 	return newScalar(g.get().NewScalar())
 }
 
+// ScalarFromInt64 returns a new scalar set to v modulo the group order. Negative values are reduced accordingly, e.g.
+// -1 yields order - 1.
+func (g Group) ScalarFromInt64(v int64) *Scalar {
+	if v >= 0 {
+		return g.NewScalar().SetUInt64(uint64(v))
+	}
+
+	// For math.MinInt64, -v overflows back to v, whose uint64 conversion is still the correct absolute value.
+	abs := g.NewScalar().SetUInt64(uint64(-v))
+
+	return g.NewScalar().Subtract(abs)
+}
+
+// ScalarToInt64 returns the int64 value of s, which is the inverse of ScalarFromInt64: scalars in [0, 2^63 - 1] are
+// positive, and scalars in [order - 2^63, order - 1] represent the negative values in [-2^63, -1]. An error is
+// returned for other scalars.
+func (g Group) ScalarToInt64(s *Scalar) (int64, error) {
+	if u, err := s.UInt64(); err == nil && u <= math.MaxInt64 {
+		return int64(u), nil
+	}
+
+	// For -2^63, the negation of int64(2^63) overflows back to -2^63, which is the expected value.
+	if u, err := g.NewScalar().Subtract(s).UInt64(); err == nil && u <= 1<<63 {
+		return -int64(u), nil
+	}
+
+	return 0, errInt64Range
+}
+
+// NegateScalarBytes returns the encoding of the negation of the scalar encoded in b, and an error if b is not a valid
+// scalar encoding.
+func (g Group) NegateScalarBytes(b []byte) ([]byte, error) {
+	s := g.NewScalar()
+	if err := s.Decode(b); err != nil {
+		return nil, fmt.Errorf("negate scalar: %w", err)
+	}
+
+	return s.Negate().Encode(), nil
+}
+
+// ScalarFromHex returns the scalar decoded from the hex string, whose length must be exactly twice the scalar length.
+// The length is checked before decoding, and a wrong length yields an error with the expected and actual lengths.
+func (g Group) ScalarFromHex(h string) (*Scalar, error) {
+	if len(h) != 2*g.ScalarLength() {
+		return nil, fmt.Errorf("scalar from hex: %w: expected %d characters, got %d", errHexLength,
+			2*g.ScalarLength(), len(h))
+	}
+
+	s := g.NewScalar()
+	if err := s.DecodeHex(h); err != nil {
+		return nil, fmt.Errorf("scalar from hex: %w", err)
+	}
+
+	return s, nil
+}
+
+// ElementFromHex returns the element decoded from the hex string, whose length must be exactly twice the element
+// length. The length is checked before decoding, and a wrong length yields an error with the expected and actual
+// lengths. As with Decode, the identity element is rejected.
+func (g Group) ElementFromHex(h string) (*Element, error) {
+	if len(h) != 2*g.ElementLength() {
+		return nil, fmt.Errorf("element from hex: %w: expected %d characters, got %d", errHexLength,
+			2*g.ElementLength(), len(h))
+	}
+
+	e := g.NewElement()
+	if err := e.DecodeHex(h); err != nil {
+		return nil, fmt.Errorf("element from hex: %w", err)
+	}
+
+	return e, nil
+}
+
+// EqualScalarField returns whether g and other have the same scalar field, in which case their scalars can be shared
+// using ImportScalarFrom.
+func (g Group) EqualScalarField(other Group) bool {
+	return g.Order() == other.Order()
+}
+
+// ImportScalarFrom returns a scalar of g with the same value as s, a scalar of the other group, if both groups have the
+// same scalar field, e.g. Ristretto255Sha512 and Edwards25519Sha512, and returns an error otherwise.
+func (g Group) ImportScalarFrom(other Group, s *Scalar) (*Scalar, error) {
+	if s == nil {
+		return nil, fmt.Errorf("import scalar: %w", internal.ErrParamNilScalar)
+	}
+
+	if !g.EqualScalarField(other) {
+		return nil, fmt.Errorf("import scalar: %w", errScalarField)
+	}
+
+	return g.NewScalar().fromBigEndian(s.bigEndian()), nil
+}
+
 // NewElement returns the identity element (point at infinity).
 func (g Group) NewElement() *Element {
 	return newPoint(g.get().NewElement())
@@ -106,6 +355,107 @@ func (g Group) Base() *Element {
 	return newPoint(g.get().Base())
 }
 
+// Generator returns a copy of the group's base point, which is computed only once per group. The returned element can
+// safely be modified by the caller.
+func (g Group) Generator() *Element {
+	g.get()
+	generatorOnce[g-1].Do(func() {
+		generators[g-1] = g.Base()
+	})
+
+	return generators[g-1].Copy()
+}
+
+// RandomElement returns a uniformly random element, computed by hashing fresh random bytes from crypto/rand to the
+// group with an internal DST, so that its discrete logarithm to the base point is unknown. It never returns the
+// identity.
+func (g Group) RandomElement() *Element {
+	dst := g.MakeDST(randomElementApp, 1)
+
+	for {
+		e := g.HashToGroup(internal.RandomBytes(randomElementInputLength), dst)
+		if !e.IsIdentity() {
+			return e
+		}
+	}
+}
+
+// NewElementFromReader is like RandomElement, but reads the random bytes from r instead of crypto/rand, e.g. to inject
+// a deterministic source in tests. The same bytes always yield the same element. It returns an error if reading from r
+// fails.
+func (g Group) NewElementFromReader(r io.Reader) (*Element, error) {
+	dst := g.MakeDST(randomElementApp, 1)
+	input := make([]byte, randomElementInputLength)
+
+	for {
+		if _, err := io.ReadFull(r, input); err != nil {
+			return nil, fmt.Errorf("element from reader: %w", err)
+		}
+
+		if e := g.HashToGroup(input, dst); !e.IsIdentity() {
+			return e, nil
+		}
+	}
+}
+
+// NewScalarFromReader returns a non-zero scalar, set to the reduction modulo the order of twice the scalar length bytes
+// read from r, as with SetWideBytes. This allows injecting a deterministic source in tests, while Random always uses
+// crypto/rand. The same bytes always yield the same scalar. It returns an error if reading from r fails.
+func (g Group) NewScalarFromReader(r io.Reader) (*Scalar, error) {
+	wide := make([]byte, 2*g.ScalarLength())
+	defer clear(wide)
+
+	s := g.NewScalar()
+
+	for s.IsZero() {
+		if _, err := io.ReadFull(r, wide); err != nil {
+			return nil, fmt.Errorf("scalar from reader: %w", err)
+		}
+
+		if err := s.SetWideBytes(wide); err != nil {
+			return nil, fmt.Errorf("scalar from reader: %w", err)
+		}
+	}
+
+	return s, nil
+}
+
+// RandomScalars returns n random non-zero scalars. All the randomness is read at once from crypto/rand, and each scalar
+// is the reduction modulo the order of its own chunk of twice the scalar length, as with SetWideBytes, so that its
+// distribution is indistinguishable from that of Random. It returns an empty slice if n is not positive.
+func (g Group) RandomScalars(n int) []*Scalar {
+	if n <= 0 {
+		return []*Scalar{}
+	}
+
+	wide := 2 * g.ScalarLength()
+	random := internal.RandomBytes(n * wide)
+	scalars := make([]*Scalar, n)
+
+	for i := range scalars {
+		scalars[i] = g.NewScalar()
+		if err := scalars[i].SetWideBytes(random[i*wide : (i+1)*wide]); err != nil {
+			panic(err)
+		}
+
+		// This happens with negligible probability.
+		if scalars[i].IsZero() {
+			scalars[i].Random()
+		}
+	}
+
+	clear(random)
+
+	return scalars
+}
+
+// ScalarBaseMult returns a new element set to the product of the group's base point and the scalar. For the NIST
+// groups, the element returned by Base is flagged as the generator, so the multiplication directly uses the curve's
+// fixed-base multiplication without the generator detection Multiply otherwise performs.
+func (g Group) ScalarBaseMult(s *Scalar) *Element {
+	return g.Base().Multiply(s)
+}
+
 func checkDST(dst []byte) {
 	if len(dst) < recommendedMinLength {
 		if len(dst) == minLength {
@@ -126,6 +476,42 @@ func (g Group) HashToScalar(input, dst []byte) *Scalar {
 	return newScalar(g.get().HashToScalar(input, dst))
 }
 
+// HashToScalars returns count independent safe mappings of the arbitrary input to Scalars, requesting all of them from
+// a single hash_to_field(msg, count) expansion. Since the expansion depends on its length, the outputs depend on count,
+// and only a count of 1 yields the same scalar as HashToScalar over the same input and DST. It returns an empty slice
+// if count is not positive, and panics if the bytes to expand for count scalars exceed the 255 blocks of hash output a
+// single expand_message_xmd can produce.
+// The DST must not be empty or nil, and is recommended to be longer than 16 bytes.
+func (g Group) HashToScalars(input, dst []byte, count int) []*Scalar {
+	checkDST(dst)
+
+	if count <= 0 {
+		return []*Scalar{}
+	}
+
+	p := g.get()
+	if uint64(count) > uint64(maxXMDBlocks*p.HashFunc().Size())/uint64(p.SecLength()) {
+		panic(errScalarCount)
+	}
+
+	s := p.HashToScalars(input, dst, uint(count))
+	scalars := make([]*Scalar, count)
+
+	for i := range scalars {
+		scalars[i] = newScalar(s[i])
+	}
+
+	return scalars
+}
+
+// HashToScalarFixedContext returns a safe mapping of the arbitrary input to a Scalar, like HashToScalar, but with a DST
+// derived internally from the context string, as MakeDST("HashToScalarFixedContext", 1) || context. The DST is
+// therefore never empty, and this doesn't panic, even for an empty context. Distinct contexts yield distinct DSTs.
+func (g Group) HashToScalarFixedContext(input []byte, context string) *Scalar {
+	dst := append(g.MakeDST(fixedContextApp, 1), context...)
+	return newScalar(g.get().HashToScalar(input, dst))
+}
+
 // HashToGroup returns a safe mapping of the arbitrary input to an Element in the Group.
 // The DST must not be empty or nil, and is recommended to be longer than 16 bytes.
 func (g Group) HashToGroup(input, dst []byte) *Element {
@@ -133,13 +519,99 @@ func (g Group) HashToGroup(input, dst []byte) *Element {
 	return newPoint(g.get().HashToGroup(input, dst))
 }
 
-// EncodeToGroup returns a non-uniform mapping of the arbitrary input to an Element in the Group.
+// secLengthHasher is implemented by the groups whose hash-to-field security length can be set.
+type secLengthHasher interface {
+	HashToGroupWithSecLength(input, dst []byte, secLength uint) internal.Element
+	SecLength() uint
+}
+
+// HashToGroupWithSecLength is like HashToGroup, but uses secLength as the security length L, in bytes, of the
+// hash-to-field step, instead of the suite's default. It panics if secLength is lower than the suite's default, which
+// is the minimum for the curve's security level. Only the NIST and Secp256k1 groups support this, and this function
+// panics for other groups. The DST must not be empty or nil, and is recommended to be longer than 16 bytes.
+func (g Group) HashToGroupWithSecLength(input, dst []byte, secLength int) *Element {
+	checkDST(dst)
+
+	h, ok := g.get().(secLengthHasher)
+	if !ok {
+		panic(errUnsupported)
+	}
+
+	if secLength < int(h.SecLength()) {
+		panic(errSecLength)
+	}
+
+	return newPoint(h.HashToGroupWithSecLength(input, dst, uint(secLength)))
+}
+
+// intermediateHasher is implemented by the groups whose HashToGroup sums two outputs of map_to_curve.
+type intermediateHasher interface {
+	HashToGroupIntermediate(input, dst []byte) (p, q0, q1 internal.Element)
+}
+
+// HashToGroupIntermediate returns the output p of HashToGroup, and the two outputs q0 and q1 of map_to_curve that are
+// summed to compute it, as specified in RFC 9380 section 3, which allows validating against the Q0 and Q1 values of the
+// test vectors. For Edwards25519, q0 and q1 are taken before cofactor clearing, and therefore are not necessarily in
+// the prime-order subgroup. Only the NIST, Secp256k1, and Edwards25519 groups support this, and this function panics
+// for other groups. The DST must not be empty or nil, and is recommended to be longer than 16 bytes.
+func (g Group) HashToGroupIntermediate(input, dst []byte) (p, q0, q1 *Element) {
+	checkDST(dst)
+
+	h, ok := g.get().(intermediateHasher)
+	if !ok {
+		panic(errUnsupported)
+	}
+
+	hp, hq0, hq1 := h.HashToGroupIntermediate(input, dst)
+
+	return newPoint(hp), newPoint(hq0), newPoint(hq1)
+}
+
+// VerifyHashToGroup returns whether the claimed element is the output of HashToGroup on the input and DST, by
+// recomputing it and comparing both in constant time. A nil claimed element is never valid.
+// The DST must not be empty or nil, and is recommended to be longer than 16 bytes.
+func (g Group) VerifyHashToGroup(input, dst []byte, claimed *Element) bool {
+	return g.HashToGroup(input, dst).Equal(claimed) == 1
+}
+
+// EncodeToGroup returns a non-uniform mapping of the arbitrary input to an Element in the Group, using the group's
+// encode-to-curve (NU_) suite, which maps a single field element. Neither RFC 9380 nor RFC 9496 define such a suite for
+// Ristretto255, for which this is the same as HashToGroup.
 // The DST must not be empty or nil, and is recommended to be longer than 16 bytes.
 func (g Group) EncodeToGroup(input, dst []byte) *Element {
 	checkDST(dst)
 	return newPoint(g.get().EncodeToGroup(input, dst))
 }
 
+// MapToCurve returns the deterministic mapping of the field element to an Element in the Group, without the hashing of
+// HashToGroup and EncodeToGroup. The map is the one of the group's hash-to-curve suite, i.e. simplified SWU for the
+// NIST groups and Secp256k1, Elligator2 for Edwards25519, and the ristretto255 MAP for Ristretto255, and cofactor
+// clearing is applied. The field element is given as its fixed-length encoding of the byte length of the field order,
+// i.e. 32 bytes, or 48 for P384 and 66 for P521, in little-endian for Ristretto255 and Edwards25519 and in big-endian
+// otherwise. An error is returned if the input has another length or is not lower than the field order. Since the
+// ristretto255 one-way map sums the MAP of both halves of its input, Ristretto255 computes
+// FromUniformBytes(fe || 0^32).
+func (g Group) MapToCurve(fe []byte) (*Element, error) {
+	p := g.get()
+	order, _ := new(big.Int).SetString(p.FieldOrder(), 10)
+
+	if len(fe) != (order.BitLen()+7)/8 {
+		return nil, errFieldElem
+	}
+
+	be := fe
+	if g == Ristretto255Sha512 || g == Edwards25519Sha512 {
+		be = slices.Clone(fe)
+		slices.Reverse(be)
+	}
+
+	if new(big.Int).SetBytes(be).Cmp(order) >= 0 {
+		return nil, errFieldElem
+	}
+
+	return newPoint(p.MapToCurve(fe)), nil
+}
+
 // ScalarLength returns the byte size of an encoded scalar.
 func (g Group) ScalarLength() int {
 	return g.get().ScalarLength()
@@ -150,11 +622,26 @@ func (g Group) ElementLength() int {
 	return g.get().ElementLength()
 }
 
-// Order returns the order of the canonical group of scalars.
+// Order returns the order of the canonical group of scalars, as a base-10 string.
 func (g Group) Order() string {
 	return g.get().Order()
 }
 
+// OrderBigInt returns a copy of the order of the canonical group of scalars, which is parsed once per group.
+func (g Group) OrderBigInt() *big.Int {
+	g.get()
+	orderOnce[g-1].Do(func() {
+		orders[g-1], _ = new(big.Int).SetString(g.Order(), 10)
+	})
+
+	return new(big.Int).Set(orders[g-1])
+}
+
+// OrderBytes returns the big-endian encoding of the order of the canonical group of scalars, without leading zeros.
+func (g Group) OrderBytes() []byte {
+	return g.OrderBigInt().Bytes()
+}
+
 func (g Group) initGroup(get func() internal.Group) {
 	groups[g-1] = get()
 }
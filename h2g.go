@@ -0,0 +1,134 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (C) 2020-2023 Daniel Bourdrez. All Rights Reserved.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree or at
+// https://spdx.org/licenses/MIT.html
+
+package crypto
+
+import (
+	"crypto"
+	"encoding"
+	"fmt"
+	"hash"
+	"slices"
+
+	"github.com/bytemare/crypto/internal"
+)
+
+// dstLongPrefix is prepended to DSTs longer than 255 bytes before hashing them, as specified in RFC 9380 section 5.3.3.
+const dstLongPrefix = "H2C-OVERSIZE-DST-"
+
+// uniformHasher is implemented by the groups whose HashToGroup maps the output of expand_message_xmd.
+type uniformHasher interface {
+	HashToGroupXMD() (crypto.Hash, uint)
+	HashUniformToGroup(uniform []byte) internal.Element
+}
+
+// MapUniformToElement returns the Element mapped from the uniform bytes, as HashToGroup does with the output of
+// expand_message_xmd, but without the expansion step. For Ristretto255, this is the one-way map from 64 uniform bytes,
+// and for the other groups, the hash-to-field reduction of both halves followed by map_to_curve, the sum, and cofactor
+// clearing. An error is returned if uniform doesn't have the exact length HashToGroup expands to, or if the group
+// doesn't map uniform bytes.
+func (g Group) MapUniformToElement(uniform []byte) (*Element, error) {
+	u, ok := g.get().(uniformHasher)
+	if !ok {
+		return nil, fmt.Errorf("map uniform: %w", errUnsupported)
+	}
+
+	if _, length := u.HashToGroupXMD(); uint(len(uniform)) != length {
+		return nil, fmt.Errorf("map uniform: %w: expected %d bytes, got %d", errUniformLen, length, len(uniform))
+	}
+
+	return newPoint(u.HashUniformToGroup(uniform)), nil
+}
+
+// HashToGroupState holds the state of a streaming HashToGroup, fed incrementally with Write. It implements io.Writer.
+type HashToGroupState struct {
+	group    uniformHasher
+	hash     hash.Hash
+	dstPrime []byte
+	id       crypto.Hash
+	length   uint
+}
+
+// NewHashToGroupState returns a new streaming HashToGroup state for the DST. Sum returns the same Element as
+// HashToGroup over the concatenation of all input written to the state, without having to buffer that input.
+// The DST must not be empty or nil, and is recommended to be longer than 16 bytes.
+func (g Group) NewHashToGroupState(dst []byte) *HashToGroupState {
+	checkDST(dst)
+
+	u, ok := g.get().(uniformHasher)
+	if !ok {
+		panic(errUnsupported)
+	}
+
+	id, length := u.HashToGroupXMD()
+	h := id.New()
+
+	if len(dst) > 255 {
+		_, _ = h.Write([]byte(dstLongPrefix))
+		_, _ = h.Write(dst)
+		dst = h.Sum(nil)
+	}
+
+	s := &HashToGroupState{
+		group:    u,
+		hash:     h,
+		dstPrime: append(slices.Clone(dst), byte(len(dst))),
+		id:       id,
+		length:   length,
+	}
+	s.Reset()
+
+	return s
+}
+
+// Reset discards all input written to the state, which can then be reused with the same DST.
+func (s *HashToGroupState) Reset() {
+	s.hash.Reset()
+	_, _ = s.hash.Write(make([]byte, s.hash.BlockSize()))
+}
+
+// Write adds more input to the state. It never returns an error.
+func (s *HashToGroupState) Write(p []byte) (int, error) {
+	return s.hash.Write(p)
+}
+
+// Sum returns the Element of the hash-to-group of all input written so far. It does not change the state, so more
+// input can be written afterwards.
+func (s *HashToGroupState) Sum() *Element {
+	state, err := s.hash.(encoding.BinaryMarshaler).MarshalBinary()
+	if err != nil {
+		panic(err)
+	}
+
+	_, _ = s.hash.Write([]byte{byte(s.length >> 8), byte(s.length), 0})
+	_, _ = s.hash.Write(s.dstPrime)
+	b0 := s.hash.Sum(nil)
+
+	if err = s.hash.(encoding.BinaryUnmarshaler).UnmarshalBinary(state); err != nil {
+		panic(err)
+	}
+
+	h := s.id.New()
+	uniform := make([]byte, 0, s.length+uint(h.Size()))
+	bi := make([]byte, h.Size())
+
+	for i := byte(1); uint(len(uniform)) < s.length; i++ {
+		for j := range bi {
+			bi[j] ^= b0[j]
+		}
+
+		h.Reset()
+		_, _ = h.Write(bi)
+		_, _ = h.Write([]byte{i})
+		_, _ = h.Write(s.dstPrime)
+		bi = h.Sum(bi[:0])
+		uniform = append(uniform, bi...)
+	}
+
+	return newPoint(s.group.HashUniformToGroup(uniform[:s.length]))
+}
@@ -61,12 +61,41 @@ func (e *Element) Double() internal.Element {
 	return e
 }
 
+// DoubleN sets the receiver to its multiplication by 2^n, and returns it. It is a no-op if n is not positive.
+func (e *Element) DoubleN(n int) internal.Element {
+	for i := 0; i < n; i++ {
+		e.element.Add(&e.element, &e.element)
+	}
+
+	return e
+}
+
 // Negate sets the receiver to its negation, and returns it.
 func (e *Element) Negate() internal.Element {
 	e.element.Negate(&e.element)
 	return e
 }
 
+// ClearCofactor sets the receiver to its multiplication by the cofactor 8, and returns it.
+func (e *Element) ClearCofactor() internal.Element {
+	e.element.MultByCofactor(&e.element)
+	return e
+}
+
+// IsInPrimeOrderSubgroup returns whether the receiver is in the prime-order subgroup, i.e. whether its multiplication
+// by the group order is the identity. Since the order doesn't fit in a reduced scalar, this checks that [l-1]e == -e.
+func (e *Element) IsInPrimeOrderSubgroup() bool {
+	minusOne := ed.NewScalar().Negate(&scOne.scalar)
+	p := ed.NewIdentityPoint().ScalarMult(minusOne, &e.element)
+
+	return p.Add(p, &e.element).Equal(ed.NewIdentityPoint()) == 1
+}
+
+// Ciphersuite returns the hash-to-curve ciphersuite identifier of the element's group.
+func (e *Element) Ciphersuite() string {
+	return H2C
+}
+
 // Subtract subtracts the input from the receiver, and returns the receiver.
 func (e *Element) Subtract(element internal.Element) internal.Element {
 	ec := checkElement(element)
@@ -88,6 +117,20 @@ func (e *Element) Multiply(scalar internal.Scalar) internal.Element {
 	return e
 }
 
+// MultiplyVartime sets the receiver to the scalar multiplication of the receiver with the given Scalar, and returns
+// it. It is not constant-time, and must only be used with public scalars.
+func (e *Element) MultiplyVartime(scalar internal.Scalar) internal.Element {
+	if scalar == nil {
+		e.Identity()
+		return e
+	}
+
+	sc := assert(scalar)
+	e.element.VarTimeDoubleScalarBaseMult(&sc.scalar, &e.element, ed.NewScalar())
+
+	return e
+}
+
 // Equal returns 1 if the elements are equivalent, and 0 otherwise.
 func (e *Element) Equal(element internal.Element) int {
 	ec := checkElement(element)
@@ -99,6 +142,17 @@ func (e *Element) IsIdentity() bool {
 	return e.element.Equal(ed.NewIdentityPoint()) == 1
 }
 
+// IsValid returns whether the element is a non-identity element of the prime-order subgroup, i.e. whether it is on the
+// curve and multiplying it by the order yields the identity. Low-order points, and points with a low-order component,
+// are therefore rejected.
+func (e *Element) IsValid() bool {
+	if e.IsIdentity() {
+		return false
+	}
+
+	return e.IsInPrimeOrderSubgroup()
+}
+
 func (e *Element) set(element *Element) *Element {
 	*e = *element
 	return e
@@ -118,6 +172,23 @@ func (e *Element) Set(element internal.Element) internal.Element {
 	return e.set(ec)
 }
 
+// CondSet sets the receiver to the argument if cond is 1, leaves it unchanged if cond is 0, and returns the receiver,
+// in constant time. The behavior is undefined if cond is not 0 or 1.
+func (e *Element) CondSet(element internal.Element, cond int) internal.Element {
+	ec := checkElement(element)
+	x1, y1, z1, t1 := e.element.ExtendedCoordinates()
+	x2, y2, z2, t2 := ec.element.ExtendedCoordinates()
+
+	if _, err := e.element.SetExtendedCoordinates(
+		x1.Select(x2, x1, cond), y1.Select(y2, y1, cond), z1.Select(z2, z1, cond), t1.Select(t2, t1, cond),
+	); err != nil {
+		// This can't happen, since the coordinates are those of a valid point.
+		panic(err)
+	}
+
+	return e
+}
+
 // Copy returns a copy of the receiver.
 func (e *Element) Copy() internal.Element {
 	return &Element{*ed.NewIdentityPoint().Set(&e.element)}
@@ -13,6 +13,7 @@ import (
 	"crypto"
 
 	ed "filippo.io/edwards25519"
+	"github.com/bytemare/hash2curve"
 
 	"github.com/bytemare/crypto/internal"
 )
@@ -56,23 +57,68 @@ func (g Group) HashToScalar(input, dst []byte) internal.Scalar {
 	return &Scalar{*HashToEdwards25519Field(input, dst)}
 }
 
+// HashToScalars returns count independent safe mappings of the arbitrary input to Scalars, from a single
+// hash_to_field expansion.
+// The DST must not be empty or nil, and is recommended to be longer than 16 bytes.
+func (g Group) HashToScalars(input, dst []byte, count uint) []internal.Scalar {
+	fields := HashToEdwards25519Fields(input, dst, count)
+	scalars := make([]internal.Scalar, count)
+
+	for i, s := range fields {
+		scalars[i] = &Scalar{*s}
+	}
+
+	return scalars
+}
+
 // HashToGroup returns a safe mapping of the arbitrary input to an Element in the Group.
 // The DST must not be empty or nil, and is recommended to be longer than 16 bytes.
 func (g Group) HashToGroup(input, dst []byte) internal.Element {
 	return &Element{*HashToEdwards25519(input, dst)}
 }
 
+// HashToGroupXMD returns the hash function and the output length of the expand_message_xmd step of HashToGroup.
+func (g Group) HashToGroupXMD() (crypto.Hash, uint) {
+	return crypto.SHA512, 2 * secLength
+}
+
+// HashUniformToGroup maps the output of the expand_message_xmd step of HashToGroup to an Element in the Group.
+func (g Group) HashUniformToGroup(uniform []byte) internal.Element {
+	return &Element{*HashUniformToEdwards25519(uniform)}
+}
+
+// HashToGroupIntermediate returns the output of HashToGroup, and the outputs Q0 and Q1 of map_to_curve it sums before
+// cofactor clearing. Q0 and Q1 are therefore not necessarily in the prime-order subgroup.
+func (g Group) HashToGroupIntermediate(input, dst []byte) (p, q0, q1 internal.Element) {
+	m0, m1 := MapUniformToEdwards25519(hash2curve.ExpandXMD(crypto.SHA512, input, dst, 2*secLength))
+	h := ed.NewIdentityPoint().Add(m0, m1)
+	h.MultByCofactor(h)
+
+	return &Element{*h}, &Element{*m0}, &Element{*m1}
+}
+
 // EncodeToGroup returns a non-uniform mapping of the arbitrary input to an Element in the Group.
 // The DST must not be empty or nil, and is recommended to be longer than 16 bytes.
 func (g Group) EncodeToGroup(input, dst []byte) internal.Element {
 	return &Element{*EncodeToEdwards25519(input, dst)}
 }
 
+// MapToCurve returns the deterministic mapping of the canonically encoded field element to an Element in the
+// Group, using the map of the hash-to-curve suite and with cofactor clearing.
+func (g Group) MapToCurve(fe []byte) internal.Element {
+	return &Element{*MapToEdwards25519(fe)}
+}
+
 // Ciphersuite returns the hash-to-curve ciphersuite identifier.
 func (g Group) Ciphersuite() string {
 	return H2C
 }
 
+// CiphersuiteNU returns the encode-to-curve ciphersuite identifier.
+func (g Group) CiphersuiteNU() string {
+	return E2C
+}
+
 // ScalarLength returns the byte size of an encoded element.
 func (g Group) ScalarLength() int {
 	return canonicalEncodingLength
@@ -87,3 +133,18 @@ func (g Group) ElementLength() int {
 func (g Group) Order() string {
 	return orderPrime
 }
+
+// FieldOrder returns the order of the base field the curve is defined over.
+func (g Group) FieldOrder() string {
+	return p25519
+}
+
+// SecLength returns the security length L of the hash-to-field step, in bytes.
+func (g Group) SecLength() uint {
+	return secLength
+}
+
+// SecurityLevel returns the target security level k of the hash-to-curve suite, in bits.
+func (g Group) SecurityLevel() uint {
+	return securityLevel
+}
@@ -21,6 +21,15 @@ const (
 	// H2C represents the hash-to-curve string identifier.
 	H2C = "edwards25519_XMD:SHA-512_ELL2_RO_"
 
+	// E2C represents the encode-to-curve string identifier.
+	E2C = "edwards25519_XMD:SHA-512_ELL2_NU_"
+
+	// secLength is the security length L, in bytes, of the hash-to-field step.
+	secLength = 48
+
+	// securityLevel is the target security level k, in bits, of the hash-to-curve suite.
+	securityLevel = 128
+
 	// p25519 is the prime 2^255 - 19 for the field.
 	// = 0x7fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffed.
 	p25519 = "57896044618658097711785492504343953926634992332820282019728792003956564819949"
@@ -92,30 +101,51 @@ func reverse(b []byte) []byte {
 
 // HashToEdwards25519Field implements hash-to-scalar mapping modulo the order of Edwards25519 using input with dst.
 func HashToEdwards25519Field(input, dst []byte) *edwards25519.Scalar {
-	sc := hash2curve.HashToFieldXMD(crypto.SHA512, input, dst, 1, 1, 48, &order)
-	b := adjust(sc[0].Bytes())
+	return HashToEdwards25519Fields(input, dst, 1)[0]
+}
 
-	s, err := edwards25519.NewScalar().SetCanonicalBytes(b)
-	if err != nil {
-		panic(err)
+// HashToEdwards25519Fields implements hash-to-field mapping to count Edwards25519 scalars of input with dst.
+func HashToEdwards25519Fields(input, dst []byte, count uint) []*edwards25519.Scalar {
+	sc := hash2curve.HashToFieldXMD(crypto.SHA512, input, dst, count, 1, secLength, &order)
+	scalars := make([]*edwards25519.Scalar, count)
+
+	for i, f := range sc {
+		s, err := edwards25519.NewScalar().SetCanonicalBytes(adjust(f.Bytes()))
+		if err != nil {
+			panic(err)
+		}
+
+		scalars[i] = s
 	}
 
-	return s
+	return scalars
 }
 
 // HashToEdwards25519 implements hash-to-curve mapping to Edwards25519 of input with dst.
 func HashToEdwards25519(input, dst []byte) *edwards25519.Point {
-	u := hash2curve.HashToFieldXMD(crypto.SHA512, input, dst, 2, 1, 48, fieldPrime)
-	q0 := element(adjust(u[0].Bytes()))
-	q1 := element(adjust(u[1].Bytes()))
-	p0 := Elligator2Edwards(q0)
-	p1 := Elligator2Edwards(q1)
+	return HashUniformToEdwards25519(hash2curve.ExpandXMD(crypto.SHA512, input, dst, 2*secLength))
+}
+
+// HashUniformToEdwards25519 implements the hash-to-curve mapping to Edwards25519 of the uniform bytes output by
+// expand_message_xmd, i.e. the hash-to-field reduction of both halves followed by the map and cofactor clearing.
+func HashUniformToEdwards25519(uniform []byte) *edwards25519.Point {
+	p0, p1 := MapUniformToEdwards25519(uniform)
 	p0.Add(p0, p1)
 	p0.MultByCofactor(p0)
 
 	return p0
 }
 
+// MapUniformToEdwards25519 returns the outputs Q0 and Q1 of the Elligator2 map for both field elements reduced from
+// the uniform bytes output by expand_message_xmd, without cofactor clearing.
+func MapUniformToEdwards25519(uniform []byte) (q0, q1 *edwards25519.Point) {
+	u0 := new(big.Int).SetBytes(uniform[:secLength])
+	u1 := new(big.Int).SetBytes(uniform[secLength:])
+
+	return Elligator2Edwards(element(adjust(u0.Mod(u0, fieldPrime).Bytes()))),
+		Elligator2Edwards(element(adjust(u1.Mod(u1, fieldPrime).Bytes())))
+}
+
 // EncodeToEdwards25519 implements encode-to-curve mapping to Edwards25519 of input with dst.
 func EncodeToEdwards25519(input, dst []byte) *edwards25519.Point {
 	q := hash2curve.HashToFieldXMD(crypto.SHA512, input, dst, 1, 1, 48, fieldPrime)
@@ -126,6 +156,15 @@ func EncodeToEdwards25519(input, dst []byte) *edwards25519.Point {
 	return p0
 }
 
+// MapToEdwards25519 implements the Elligator2 mapping of the little-endian encoded field element to Edwards25519,
+// followed by cofactor clearing.
+func MapToEdwards25519(fe []byte) *edwards25519.Point {
+	p := Elligator2Edwards(element(fe))
+	p.MultByCofactor(p)
+
+	return p
+}
+
 // Elligator2Edwards maps the field element to a point on Edwards25519.
 func Elligator2Edwards(e *field.Element) *edwards25519.Point {
 	u, v := Elligator2Montgomery(e)
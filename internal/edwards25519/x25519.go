@@ -0,0 +1,83 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (C) 2020-2023 Daniel Bourdrez. All Rights Reserved.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree or at
+// https://spdx.org/licenses/MIT.html
+
+package edwards25519
+
+import (
+	"github.com/bytemare/crypto/internal"
+)
+
+const (
+	// x25519Length is the byte size of X25519 scalars and u-coordinates.
+	x25519Length = 32
+
+	// a24 is (486662 + 2) / 4, from the Montgomery curve coefficient A. The ladder below computes z_2 with BB rather
+	// than AA as in RFC 7748, which uses (A - 2) / 4.
+	a24 = 121666
+)
+
+// X25519 implements the RFC 7748 X25519 function: it clamps the scalar and returns the u-coordinate of the scalar
+// multiple of the point with the given u-coordinate, using a constant-time Montgomery ladder.
+func X25519(scalar, uCoordinate []byte) ([]byte, error) {
+	if len(scalar) != x25519Length {
+		return nil, internal.ErrParamScalarLength
+	}
+
+	if len(uCoordinate) != x25519Length {
+		return nil, internal.ErrParamInvalidPointEncoding
+	}
+
+	var k [x25519Length]byte
+	copy(k[:], scalar)
+	k[0] &= 248
+	k[31] &= 127
+	k[31] |= 64
+
+	// SetBytes masks the most significant bit, as required by RFC 7748.
+	x1, err := fe().SetBytes(uCoordinate)
+	if err != nil {
+		return nil, internal.ErrParamInvalidPointEncoding
+	}
+
+	x2, z2 := fe().One(), fe().Zero()
+	x3, z3 := fe().Set(x1), fe().One()
+	tmp0, tmp1 := fe(), fe()
+	swap := 0
+
+	for pos := 254; pos >= 0; pos-- {
+		b := int(k[pos/8]>>uint(pos&7)) & 1
+		swap ^= b
+		x2.Swap(x3, swap)
+		z2.Swap(z3, swap)
+		swap = b
+
+		tmp0.Subtract(x3, z3)
+		tmp1.Subtract(x2, z2)
+		x2.Add(x2, z2)
+		z2.Add(x3, z3)
+		z3.Multiply(tmp0, x2)
+		z2.Multiply(z2, tmp1)
+		tmp0.Square(tmp1)
+		tmp1.Square(x2)
+		x3.Add(z3, z2)
+		z2.Subtract(z3, z2)
+		x2.Multiply(tmp1, tmp0)
+		tmp1.Subtract(tmp1, tmp0)
+		z2.Square(z2)
+		z3.Mult32(tmp1, a24)
+		x3.Square(x3)
+		tmp0.Add(tmp0, z3)
+		z3.Multiply(x1, z2)
+		z2.Multiply(tmp1, tmp0)
+	}
+
+	x2.Swap(x3, swap)
+	z2.Swap(z3, swap)
+
+	return x2.Multiply(x2, z2.Invert(z2)).Bytes(), nil
+}
@@ -23,24 +23,48 @@ type Element interface {
 	// Double sets the receiver to its double, and returns it.
 	Double() Element
 
+	// DoubleN sets the receiver to its multiplication by 2^n, and returns it. It is a no-op if n is not positive.
+	DoubleN(n int) Element
+
 	// Negate sets the receiver to its negation, and returns it.
 	Negate() Element
 
+	// ClearCofactor sets the receiver to its multiplication by the group's cofactor, and returns it.
+	ClearCofactor() Element
+
+	// IsInPrimeOrderSubgroup returns whether the receiver is in the prime-order subgroup.
+	IsInPrimeOrderSubgroup() bool
+
+	// Ciphersuite returns the hash-to-curve ciphersuite identifier of the element's group.
+	Ciphersuite() string
+
 	// Subtract subtracts the input from the receiver, and returns the receiver.
 	Subtract(Element) Element
 
 	// Multiply sets the receiver to the scalar multiplication of the receiver with the given Scalar, and returns it.
 	Multiply(Scalar) Element
 
+	// MultiplyVartime sets the receiver to the scalar multiplication of the receiver with the given Scalar, and returns
+	// it. It is not constant-time, and must only be used with public scalars.
+	MultiplyVartime(Scalar) Element
+
 	// Equal returns 1 if the elements are equivalent, and 0 otherwise.
 	Equal(Element) int
 
 	// IsIdentity returns whether the Element is the point at infinity of the Group's underlying curve.
 	IsIdentity() bool
 
+	// IsValid returns whether the element is a non-identity element of the prime-order group, i.e. on the curve and,
+	// for groups with a cofactor, in the prime-order subgroup.
+	IsValid() bool
+
 	// Set sets the receiver to the value of the argument, and returns the receiver.
 	Set(Element) Element
 
+	// CondSet sets the receiver to the argument if cond is 1, leaves it unchanged if cond is 0, and returns the
+	// receiver, in constant time.
+	CondSet(element Element, cond int) Element
+
 	// Copy returns a copy of the receiver.
 	Copy() Element
 
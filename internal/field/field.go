@@ -134,6 +134,11 @@ func (f Field) Sub(res, x, y *big.Int) *big.Int {
 	return f.Mod(res.Sub(x, y))
 }
 
+// Neg sets res to -x modulo the field order.
+func (f Field) Neg(res, x *big.Int) *big.Int {
+	return f.Mod(res.Neg(x))
+}
+
 // Mul sets res to the multiplication of x and y modulo the field order.
 func (f Field) Mul(res, x, y *big.Int) {
 	f.Mod(res.Mul(x, y))
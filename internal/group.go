@@ -29,6 +29,11 @@ type Group interface {
 	// The DST must not be empty or nil, and is recommended to be longer than 16 bytes.
 	HashToScalar(input, dst []byte) Scalar
 
+	// HashToScalars returns count independent safe mappings of the arbitrary input to Scalars, from a single
+	// hash_to_field expansion. For a count of 1, this is the same as HashToScalar.
+	// The DST must not be empty or nil, and is recommended to be longer than 16 bytes.
+	HashToScalars(input, dst []byte, count uint) []Scalar
+
 	// HashToGroup returns a safe mapping of the arbitrary input to an Element in the Group.
 	// The DST must not be empty or nil, and is recommended to be longer than 16 bytes.
 	HashToGroup(input, dst []byte) Element
@@ -37,9 +42,16 @@ type Group interface {
 	// The DST must not be empty or nil, and is recommended to be longer than 16 bytes.
 	EncodeToGroup(input, dst []byte) Element
 
+	// MapToCurve returns the deterministic mapping of the canonically encoded field element to an Element in the
+	// Group, using the map of the hash-to-curve suite and with cofactor clearing.
+	MapToCurve(fe []byte) Element
+
 	// Ciphersuite returns the hash-to-curve ciphersuite identifier.
 	Ciphersuite() string
 
+	// CiphersuiteNU returns the encode-to-curve ciphersuite identifier.
+	CiphersuiteNU() string
+
 	// ScalarLength returns the byte size of an encoded scalar.
 	ScalarLength() int
 
@@ -48,4 +60,13 @@ type Group interface {
 
 	// Order returns the order of the canonical group of scalars.
 	Order() string
+
+	// FieldOrder returns the order of the base field the curve is defined over.
+	FieldOrder() string
+
+	// SecLength returns the security length L of the hash-to-field step, in bytes.
+	SecLength() uint
+
+	// SecurityLevel returns the target security level k of the hash-to-curve suite, in bits.
+	SecurityLevel() uint
 }
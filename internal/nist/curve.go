@@ -18,9 +18,10 @@ import (
 )
 
 type mapping struct {
-	z         big.Int
-	hash      crypto.Hash
-	secLength uint
+	z             big.Int
+	hash          crypto.Hash
+	secLength     uint
+	securityLevel uint
 }
 
 type curve[point nistECPoint[point]] struct {
@@ -30,9 +31,10 @@ type curve[point nistECPoint[point]] struct {
 	mapping
 }
 
-func (c *curve[point]) setMapping(hash crypto.Hash, z string, secLength uint) {
+func (c *curve[point]) setMapping(hash crypto.Hash, z string, secLength, securityLevel uint) {
 	c.mapping.hash = hash
 	c.mapping.secLength = secLength
+	c.mapping.securityLevel = securityLevel
 	c.mapping.z = field.String2Int(z)
 }
 
@@ -50,13 +52,27 @@ func (c *curve[point]) encodeXMD(input, dst []byte) point {
 }
 
 func (c *curve[point]) hashXMD(input, dst []byte) point {
-	u := hash2curve.HashToFieldXMD(c.hash, input, dst, 2, 1, c.secLength, c.field.Order())
-	q0 := c.map2curve(u[0])
-	q1 := c.map2curve(u[1])
+	return c.hashXMDWithSecLength(input, dst, c.secLength)
+}
+
+func (c *curve[point]) hashXMDWithSecLength(input, dst []byte, secLength uint) point {
+	return c.hashUniform(hash2curve.ExpandXMD(c.hash, input, dst, 2*secLength), secLength)
+}
+
+func (c *curve[point]) hashUniform(uniform []byte, secLength uint) point {
+	q0, q1 := c.mapUniform(uniform, secLength)
 	// We can save cofactor clearing because it is 1.
 	return q0.Add(q0, q1)
 }
 
+// mapUniform returns the outputs of map_to_curve for both field elements reduced from the uniform bytes.
+func (c *curve[point]) mapUniform(uniform []byte, secLength uint) (q0, q1 point) {
+	u0 := new(big.Int).SetBytes(uniform[:secLength])
+	u1 := new(big.Int).SetBytes(uniform[secLength:])
+
+	return c.map2curve(u0.Mod(u0, c.field.Order())), c.map2curve(u1.Mod(u1, c.field.Order()))
+}
+
 func (c *curve[point]) map2curve(fe *big.Int) point {
 	x, y := hash2curve.MapToCurveSSWU(&nistWa, &c.b, &c.z, fe, c.field.Order())
 	return c.affineToPoint(x, y)
@@ -13,6 +13,8 @@ import (
 	"encoding/hex"
 	"fmt"
 
+	"filippo.io/nistec"
+
 	"github.com/bytemare/crypto/internal"
 )
 
@@ -26,6 +28,10 @@ const (
 type Element[Point nistECPoint[Point]] struct {
 	p   Point
 	new func() Point
+
+	// isBase is set when the element is known to be the base point, and saves the comparison in Multiply. It is
+	// cleared by all operations modifying the element, and unset doesn't mean the element is not the base point.
+	isBase bool
 }
 
 func checkElement[Point nistECPoint[Point]](element internal.Element) *Element[Point] {
@@ -44,12 +50,16 @@ func checkElement[Point nistECPoint[Point]](element internal.Element) *Element[P
 // Base sets the element to the group's base point a.k.a. canonical generator.
 func (e *Element[Point]) Base() internal.Element {
 	e.p.SetGenerator()
+	e.isBase = true
+
 	return e
 }
 
 // Identity sets the element to the point at infinity of the Group's underlying curve.
 func (e *Element[Point]) Identity() internal.Element {
 	e.p = e.new()
+	e.isBase = false
+
 	return e
 }
 
@@ -57,6 +67,7 @@ func (e *Element[Point]) Identity() internal.Element {
 func (e *Element[Point]) Add(element internal.Element) internal.Element {
 	ec := checkElement[Point](element)
 	e.p.Add(e.p, ec.p)
+	e.isBase = false
 
 	return e
 }
@@ -64,6 +75,18 @@ func (e *Element[Point]) Add(element internal.Element) internal.Element {
 // Double sets the receiver to its double, and returns it.
 func (e *Element[Point]) Double() internal.Element {
 	e.p.Double(e.p)
+	e.isBase = false
+
+	return e
+}
+
+// DoubleN sets the receiver to its multiplication by 2^n, and returns it. It is a no-op if n is not positive.
+func (e *Element[Point]) DoubleN(n int) internal.Element {
+	for i := 0; i < n; i++ {
+		e.p.Double(e.p)
+		e.isBase = false
+	}
+
 	return e
 }
 
@@ -94,9 +117,33 @@ func (e *Element[P]) Negate() internal.Element {
 		panic(err)
 	}
 
+	e.isBase = false
+
 	return e
 }
 
+// ClearCofactor is a no-op and returns the receiver, since the cofactor is 1.
+func (e *Element[Point]) ClearCofactor() internal.Element {
+	return e
+}
+
+// IsInPrimeOrderSubgroup always returns true, since the cofactor is 1.
+func (e *Element[Point]) IsInPrimeOrderSubgroup() bool {
+	return true
+}
+
+// Ciphersuite returns the hash-to-curve ciphersuite identifier of the element's group.
+func (e *Element[Point]) Ciphersuite() string {
+	switch any(e.p).(type) {
+	case *nistec.P256Point:
+		return H2CP256
+	case *nistec.P384Point:
+		return H2CP384
+	default:
+		return H2CP521
+	}
+}
+
 // Subtract subtracts the input from the receiver, and returns the receiver.
 func (e *Element[P]) Subtract(element internal.Element) internal.Element {
 	ec := checkElement[P](element).negateSmall()
@@ -107,6 +154,7 @@ func (e *Element[P]) Subtract(element internal.Element) internal.Element {
 	}
 
 	e.p.Add(e.p, p)
+	e.isBase = false
 
 	return e
 }
@@ -118,7 +166,7 @@ func (e *Element[P]) isGenerator() bool {
 
 // Multiply sets the receiver to the scalar multiplication of the receiver with the given Scalar, and returns it.
 func (e *Element[P]) Multiply(scalar internal.Scalar) internal.Element {
-	if e.isGenerator() {
+	if e.isBase || e.isGenerator() {
 		if _, err := e.p.ScalarBaseMult(scalar.Encode()); err != nil {
 			panic(err)
 		}
@@ -128,6 +176,8 @@ func (e *Element[P]) Multiply(scalar internal.Scalar) internal.Element {
 		}
 	}
 
+	e.isBase = false
+
 	return e
 }
 
@@ -146,6 +196,18 @@ func (e *Element[P]) IsIdentity() bool {
 	return subtle.ConstantTimeCompare(b, i) == 1
 }
 
+// IsValid returns whether the element is a non-identity point on the curve. The NIST curves have a cofactor of 1, so
+// all such points are in the prime-order group.
+func (e *Element[P]) IsValid() bool {
+	if e.IsIdentity() {
+		return false
+	}
+
+	_, err := e.new().SetBytes(e.p.Bytes())
+
+	return err == nil
+}
+
 // Set sets the receiver to the value of the argument, and returns the receiver.
 func (e *Element[P]) Set(element internal.Element) internal.Element {
 	if element == nil {
@@ -158,6 +220,17 @@ func (e *Element[P]) Set(element internal.Element) internal.Element {
 	}
 
 	e.p.Set(ec.p)
+	e.isBase = ec.isBase
+
+	return e
+}
+
+// CondSet sets the receiver to the argument if cond is 1, leaves it unchanged if cond is 0, and returns the receiver,
+// in constant time. The behavior is undefined if cond is not 0 or 1.
+func (e *Element[P]) CondSet(element internal.Element, cond int) internal.Element {
+	ec := checkElement[P](element)
+	e.p.Select(ec.p, e.p, cond)
+	e.isBase = false
 
 	return e
 }
@@ -165,8 +238,9 @@ func (e *Element[P]) Set(element internal.Element) internal.Element {
 // Copy returns a copy of the receiver.
 func (e *Element[P]) Copy() internal.Element {
 	return &Element[P]{
-		p:   e.new().Set(e.p),
-		new: e.new,
+		p:      e.new().Set(e.p),
+		new:    e.new,
+		isBase: e.isBase,
 	}
 }
 
@@ -218,6 +292,39 @@ func (e *Element[P]) Decode(data []byte) error {
 		return fmt.Errorf("%w", err)
 	}
 
+	e.isBase = false
+
+	return nil
+}
+
+// uncompressedLength returns the byte length of the uncompressed encoding of the curve's elements.
+func (e *Element[P]) uncompressedLength() int {
+	return 2*len(encodeInfinity(&Element[P]{p: e.new()})) - 1
+}
+
+// EncodeUncompressed returns the SEC1 uncompressed encoding of the element, i.e. 0x04 || X || Y. The identity element
+// is encoded as a zero-filled slice of the same length.
+func (e *Element[P]) EncodeUncompressed() []byte {
+	if e.IsIdentity() {
+		return make([]byte, e.uncompressedLength())
+	}
+
+	return e.p.Bytes()
+}
+
+// DecodeUncompressed sets the receiver to the decoding of the SEC1 uncompressed encoding, and returns an error on
+// failure.
+func (e *Element[P]) DecodeUncompressed(data []byte) error {
+	if len(data) != e.uncompressedLength() || data[0] != 4 {
+		return internal.ErrParamInvalidPointEncoding
+	}
+
+	if _, err := e.p.SetBytes(data); err != nil {
+		return fmt.Errorf("%w", err)
+	}
+
+	e.isBase = false
+
 	return nil
 }
 
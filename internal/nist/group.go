@@ -64,12 +64,13 @@ func P521() internal.Group {
 type Group[Point nistECPoint[Point]] struct {
 	scalarField field.Field
 	h2c         string
+	e2c         string
 	curve       curve[Point]
 }
 
 // NewScalar returns a new scalar set to 0.
 func (g Group[P]) NewScalar() internal.Scalar {
-	return newScalar(&g.scalarField)
+	return newScalar(&g.scalarField, g.h2c)
 }
 
 // NewElement returns the identity element (point at infinity).
@@ -85,7 +86,10 @@ func (g Group[P]) Base() internal.Element {
 	b := g.curve.NewPoint()
 	b.SetGenerator()
 
-	return g.newPoint(b)
+	e := g.newPoint(b)
+	e.isBase = true
+
+	return e
 }
 
 func (g Group[P]) newPoint(p P) *Element[P] {
@@ -103,22 +107,33 @@ func (g Group[P]) HashFunc() crypto.Hash {
 // HashToScalar returns a safe mapping of the arbitrary input to a Scalar.
 // The DST must not be empty or nil, and is recommended to be longer than 16 bytes.
 func (g Group[P]) HashToScalar(input, dst []byte) internal.Scalar {
-	s := hash2curve.HashToFieldXMD(g.curve.hash, input, dst, 1, 1, g.curve.secLength, g.scalarField.Order())[0]
-
-	// If necessary, build a buffer of right size, so it gets correctly interpreted.
-	bytes := s.Bytes()
+	return g.HashToScalars(input, dst, 1)[0]
+}
 
-	length := g.ScalarLength()
-	if l := length - len(bytes); l > 0 {
-		buf := make([]byte, l, length)
-		buf = append(buf, bytes...)
-		bytes = buf
+// HashToScalars returns count independent safe mappings of the arbitrary input to Scalars, from a single
+// hash_to_field expansion.
+// The DST must not be empty or nil, and is recommended to be longer than 16 bytes.
+func (g Group[P]) HashToScalars(input, dst []byte, count uint) []internal.Scalar {
+	fields := hash2curve.HashToFieldXMD(g.curve.hash, input, dst, count, 1, g.curve.secLength, g.scalarField.Order())
+	scalars := make([]internal.Scalar, count)
+
+	for i, s := range fields {
+		// If necessary, build a buffer of right size, so it gets correctly interpreted.
+		bytes := s.Bytes()
+
+		length := g.ScalarLength()
+		if l := length - len(bytes); l > 0 {
+			buf := make([]byte, l, length)
+			buf = append(buf, bytes...)
+			bytes = buf
+		}
+
+		res := newScalar(&g.scalarField, g.h2c)
+		res.scalar.SetBytes(bytes)
+		scalars[i] = res
 	}
 
-	res := newScalar(&g.scalarField)
-	res.scalar.SetBytes(bytes)
-
-	return res
+	return scalars
 }
 
 // HashToGroup returns a safe mapping of the arbitrary input to an Element in the Group.
@@ -127,17 +142,63 @@ func (g Group[P]) HashToGroup(input, dst []byte) internal.Element {
 	return g.newPoint(g.curve.hashXMD(input, dst))
 }
 
+// HashToGroupWithSecLength is like HashToGroup, but uses secLength as the security length L of the hash-to-field
+// step, which must not be lower than SecLength.
+func (g Group[P]) HashToGroupWithSecLength(input, dst []byte, secLength uint) internal.Element {
+	return g.newPoint(g.curve.hashXMDWithSecLength(input, dst, secLength))
+}
+
+// HashToGroupIntermediate returns the output of HashToGroup, and the outputs Q0 and Q1 of map_to_curve it sums.
+func (g Group[P]) HashToGroupIntermediate(input, dst []byte) (p, q0, q1 internal.Element) {
+	uniform := hash2curve.ExpandXMD(g.curve.hash, input, dst, 2*g.curve.secLength)
+	m0, m1 := g.curve.mapUniform(uniform, g.curve.secLength)
+
+	return g.newPoint(g.curve.NewPoint().Add(m0, m1)), g.newPoint(m0), g.newPoint(m1)
+}
+
+// SecLength returns the default security length L of the hash-to-field step, in bytes.
+func (g Group[P]) SecLength() uint {
+	return g.curve.secLength
+}
+
+// SecurityLevel returns the target security level k of the hash-to-curve suite, in bits.
+func (g Group[P]) SecurityLevel() uint {
+	return g.curve.securityLevel
+}
+
+// HashToGroupXMD returns the hash function and the output length of the expand_message_xmd step of HashToGroup.
+func (g Group[P]) HashToGroupXMD() (crypto.Hash, uint) {
+	return g.curve.hash, 2 * g.curve.secLength
+}
+
+// HashUniformToGroup maps the output of the expand_message_xmd step of HashToGroup to an Element in the Group.
+func (g Group[P]) HashUniformToGroup(uniform []byte) internal.Element {
+	return g.newPoint(g.curve.hashUniform(uniform, g.curve.secLength))
+}
+
 // EncodeToGroup returns a non-uniform mapping of the arbitrary input to an Element in the Group.
 // The DST must not be empty or nil, and is recommended to be longer than 16 bytes.
 func (g Group[P]) EncodeToGroup(input, dst []byte) internal.Element {
 	return g.newPoint(g.curve.encodeXMD(input, dst))
 }
 
+// MapToCurve returns the deterministic mapping of the canonically encoded field element to an Element in the
+// Group, using the map of the hash-to-curve suite and with cofactor clearing.
+func (g Group[P]) MapToCurve(fe []byte) internal.Element {
+	// We can save cofactor clearing because it is 1.
+	return g.newPoint(g.curve.map2curve(new(big.Int).SetBytes(fe)))
+}
+
 // Ciphersuite returns the hash-to-curve ciphersuite identifier.
 func (g Group[P]) Ciphersuite() string {
 	return g.h2c
 }
 
+// CiphersuiteNU returns the encode-to-curve ciphersuite identifier.
+func (g Group[P]) CiphersuiteNU() string {
+	return g.e2c
+}
+
 // ScalarLength returns the byte size of an encoded element.
 func (g Group[P]) ScalarLength() int {
 	byteLen := (g.scalarField.BitLen() + 7) / 8
@@ -155,6 +216,11 @@ func (g Group[P]) Order() string {
 	return g.scalarField.Order().String()
 }
 
+// FieldOrder returns the order of the base field the curve is defined over.
+func (g Group[P]) FieldOrder() string {
+	return g.curve.field.Order().String()
+}
+
 var (
 	initOnceP256 sync.Once
 	initOnceP384 sync.Once
@@ -171,12 +237,13 @@ func initP256() {
 	primeP256, _ := new(big.Int).SetString("115792089210356248762697446949407573530"+
 		"086143415290314195533631308867097853951", 10)
 	p256.h2c = H2CP256
+	p256.e2c = E2CP256
 	p256.curve.setCurveParams(
 		primeP256,
 		"0x5ac635d8aa3a93e7b3ebbd55769886bc651d06b0cc53b0f63bce3c3e27d2604b",
 		nistec.NewP256Point,
 	)
-	p256.curve.setMapping(crypto.SHA256, "-10", 48)
+	p256.curve.setMapping(crypto.SHA256, "-10", 48, 128)
 	setScalarField(&p256, "0xffffffff00000000ffffffffffffffffbce6faada7179e84f3b9cac2fc632551")
 }
 
@@ -184,12 +251,13 @@ func initP384() {
 	primeP384, _ := new(big.Int).SetString("3940200619639447921227904010014361380507973927046544666794"+
 		"8293404245721771496870329047266088258938001861606973112319", 10)
 	p384.h2c = H2CP384
+	p384.e2c = E2CP384
 	p384.curve.setCurveParams(
 		primeP384,
 		"0xb3312fa7e23ee7e4988e056be3f82d19181d9c6efe8141120314088f5013875ac656398d8a2ed19d2a85c8edd3ec2aef",
 		nistec.NewP384Point,
 	)
-	p384.curve.setMapping(crypto.SHA384, "-12", 72)
+	p384.curve.setMapping(crypto.SHA384, "-12", 72, 192)
 	setScalarField(&p384,
 		"0xffffffffffffffffffffffffffffffffffffffffffffffffc7634d81f4372ddf581a0db248b0a77aecec196accc52973",
 	)
@@ -200,13 +268,14 @@ func initP521() {
 		"4093944634591855431833976560521225596406614545549772"+
 		"96311391480858037121987999716643812574028291115057151", 10)
 	p521.h2c = H2CP521
+	p521.e2c = E2CP521
 	p521.curve.setCurveParams(
 		primeP521,
 		"0x051953eb9618e1c9a1f929a21a0b68540eea2da725b99b315f3b8b489918ef10"+
 			"9e156193951ec7e937b1652c0bd3bb1bf073573df883d2c34f1ef451fd46b503f00",
 		nistec.NewP521Point,
 	)
-	p521.curve.setMapping(crypto.SHA512, "-4", 98)
+	p521.curve.setMapping(crypto.SHA512, "-4", 98, 256)
 	setScalarField(&p521,
 		"0x1fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff"+
 			"a51868783bf2f966b7fcc0148f709a5d03bb5c9b8899c47aebb6fb71e91386409",
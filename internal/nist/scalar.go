@@ -22,12 +22,14 @@ import (
 // Scalar implements the Scalar interface for group scalars.
 type Scalar struct {
 	field  *field.Field
+	h2c    string
 	scalar big.Int
 }
 
-func newScalar(f *field.Field) *Scalar {
+func newScalar(f *field.Field, h2c string) *Scalar {
 	s := &Scalar{
 		field:  f,
+		h2c:    h2c,
 		scalar: big.Int{},
 	}
 	s.scalar.Set(s.field.Zero())
@@ -84,6 +86,12 @@ func (s *Scalar) Add(scalar internal.Scalar) internal.Scalar {
 	return s
 }
 
+// Double sets the receiver to its double, and returns the receiver.
+func (s *Scalar) Double() internal.Scalar {
+	s.field.Add(&s.scalar, &s.scalar, &s.scalar)
+	return s
+}
+
 // Subtract subtracts the input from the receiver, and returns the receiver.
 func (s *Scalar) Subtract(scalar internal.Scalar) internal.Scalar {
 	if scalar == nil {
@@ -96,6 +104,12 @@ func (s *Scalar) Subtract(scalar internal.Scalar) internal.Scalar {
 	return s
 }
 
+// Negate sets the receiver to its additive inverse, and returns the receiver.
+func (s *Scalar) Negate() internal.Scalar {
+	s.field.Neg(&s.scalar, &s.scalar)
+	return s
+}
+
 // Multiply multiplies the receiver with the input, and returns the receiver.
 func (s *Scalar) Multiply(scalar internal.Scalar) internal.Scalar {
 	if scalar == nil {
@@ -108,13 +122,25 @@ func (s *Scalar) Multiply(scalar internal.Scalar) internal.Scalar {
 	return s
 }
 
+// MulAdd sets the receiver to receiver * b + c, and returns the receiver, with a single reduction. The arguments can
+// alias the receiver.
+func (s *Scalar) MulAdd(b, c internal.Scalar) internal.Scalar {
+	var product big.Int
+
+	product.Mul(&s.scalar, &s.assert(b).scalar)
+	product.Add(&product, &s.assert(c).scalar)
+	s.scalar.Set(s.field.Mod(&product))
+
+	return s
+}
+
 // Pow sets s to s**scalar modulo the group order, and returns s. If scalar is nil, it returns 1.
 func (s *Scalar) Pow(scalar internal.Scalar) internal.Scalar {
 	if scalar == nil || scalar.IsZero() {
 		return s.One()
 	}
 
-	if scalar.Equal(newScalar(s.field).One()) == 1 {
+	if scalar.Equal(newScalar(s.field, s.h2c).One()) == 1 {
 		return s
 	}
 
@@ -124,7 +150,8 @@ func (s *Scalar) Pow(scalar internal.Scalar) internal.Scalar {
 	return s
 }
 
-// Invert sets the receiver to its modular inverse ( 1 / s ), and returns it.
+// Invert sets the receiver to its modular inverse ( 1 / s ), and returns it. Since this uses Fermat's little theorem,
+// 0 is left unchanged.
 func (s *Scalar) Invert() internal.Scalar {
 	s.field.Inv(&s.scalar, &s.scalar)
 	return s
@@ -141,6 +168,12 @@ func (s *Scalar) Equal(scalar internal.Scalar) int {
 	return subtle.ConstantTimeCompare(s.scalar.Bytes(), sc.scalar.Bytes())
 }
 
+// Cmp returns -1 if s < scalar, 0 if s == scalar, and 1 if s > scalar. It is not constant-time.
+func (s *Scalar) Cmp(scalar internal.Scalar) int {
+	sc := s.assert(scalar)
+	return s.scalar.Cmp(&sc.scalar)
+}
+
 // LessOrEqual returns 1 if s <= scalar, and 0 otherwise.
 func (s *Scalar) LessOrEqual(scalar internal.Scalar) int {
 	sc := s.assert(scalar)
@@ -171,6 +204,25 @@ func (s *Scalar) IsZero() bool {
 	return s.field.AreEqual(&s.scalar, s.field.Zero())
 }
 
+// IsOne returns whether the scalar is 1, comparing the fixed-length encodings in constant time.
+func (s *Scalar) IsOne() bool {
+	enc := s.Encode()
+	one := make([]byte, len(enc))
+	one[len(one)-1] = 1
+
+	return subtle.ConstantTimeCompare(enc, one) == 1
+}
+
+// IsLittleEndian returns whether the scalar's encoding is in little-endian.
+func (s *Scalar) IsLittleEndian() bool {
+	return false
+}
+
+// Ciphersuite returns the hash-to-curve ciphersuite identifier of the scalar's group.
+func (s *Scalar) Ciphersuite() string {
+	return s.h2c
+}
+
 // Set sets the receiver to the value of the argument scalar, and returns the receiver.
 func (s *Scalar) Set(scalar internal.Scalar) internal.Scalar {
 	if scalar == nil {
@@ -183,6 +235,20 @@ func (s *Scalar) Set(scalar internal.Scalar) internal.Scalar {
 	return s
 }
 
+// CondSet sets the receiver to the argument if cond is 1, leaves it unchanged if cond is 0, and returns the receiver.
+// The selection is done in constant time on the encodings. The behavior is undefined if cond is not 0 or 1.
+func (s *Scalar) CondSet(scalar internal.Scalar, cond int) internal.Scalar {
+	sc := s.assert(scalar)
+	enc := s.Encode()
+	subtle.ConstantTimeCopy(cond, enc, sc.Encode())
+
+	if err := s.Decode(enc); err != nil {
+		panic(err)
+	}
+
+	return s
+}
+
 // SetUInt64 sets s to i modulo the field order, and returns an error if one occurs.
 func (s *Scalar) SetUInt64(i uint64) internal.Scalar {
 	s.scalar.SetUint64(i)
@@ -209,13 +275,13 @@ func (s *Scalar) UInt64() (uint64, error) {
 
 // Copy returns a copy of the Scalar.
 func (s *Scalar) Copy() internal.Scalar {
-	cpy := newScalar(s.field)
+	cpy := newScalar(s.field, s.h2c)
 	cpy.scalar.Set(&s.scalar)
 
 	return cpy
 }
 
-// Encode returns the compressed byte encoding of the scalar.
+// Encode returns the compressed byte encoding of the scalar, in a new slice on each call.
 func (s *Scalar) Encode() []byte {
 	byteLen := (s.field.BitLen() + 7) / 8
 	scalar := make([]byte, byteLen)
@@ -223,6 +289,18 @@ func (s *Scalar) Encode() []byte {
 	return s.scalar.FillBytes(scalar)
 }
 
+// SetWideBytes sets the receiver to the reduction modulo the group order of the big-endian input of twice the scalar
+// length, and returns an error on failure.
+func (s *Scalar) SetWideBytes(in []byte) error {
+	if len(in) != 2*((s.field.BitLen()+7)/8) {
+		return internal.ErrParamScalarLength
+	}
+
+	s.scalar.Set(s.field.Mod(new(big.Int).SetBytes(in)))
+
+	return nil
+}
+
 // Decode sets the receiver to a decoding of the input data, and returns an error on failure.
 func (s *Scalar) Decode(in []byte) error {
 	expectedLength := (s.field.BitLen() + 7) / 8
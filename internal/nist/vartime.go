@@ -0,0 +1,78 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (C) 2020-2023 Daniel Bourdrez. All Rights Reserved.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree or at
+// https://spdx.org/licenses/MIT.html
+
+package nist
+
+import (
+	"math/big"
+
+	"github.com/bytemare/crypto/internal"
+)
+
+// wnafWidth is the window width of the non-adjacent form used for variable-time multiplication, for which the odd
+// multiples P, 3P, ..., 15P are precomputed.
+const wnafWidth = 5
+
+// wnaf returns the width-w non-adjacent form of k, least significant digit first.
+func wnaf(k *big.Int, w uint) []int8 {
+	k = new(big.Int).Set(k)
+	naf := make([]int8, 0, k.BitLen()+1)
+	window := int64(1) << w
+
+	for k.Sign() > 0 {
+		var d int64
+
+		if k.Bit(0) == 1 {
+			d = int64(k.Bits()[0]) & (window - 1)
+			if d >= window/2 {
+				d -= window
+			}
+
+			k.Sub(k, big.NewInt(d))
+		}
+
+		naf = append(naf, int8(d))
+		k.Rsh(k, 1)
+	}
+
+	return naf
+}
+
+// MultiplyVartime sets the receiver to the scalar multiplication of the receiver with the given Scalar, and returns
+// it. It uses a width-5 non-adjacent form of the scalar, and is not constant-time: it must only be used with public
+// scalars.
+func (e *Element[P]) MultiplyVartime(scalar internal.Scalar) internal.Element {
+	naf := wnaf(new(big.Int).SetBytes(scalar.Encode()), wnafWidth)
+
+	// table[i] = (2i+1) * e
+	table := make([]P, 1<<(wnafWidth-2))
+	table[0] = e.new().Set(e.p)
+	double := e.new().Double(e.p)
+
+	for i := 1; i < len(table); i++ {
+		table[i] = e.new().Add(table[i-1], double)
+	}
+
+	acc, neg := e.new(), e.new()
+
+	for i := len(naf) - 1; i >= 0; i-- {
+		acc.Double(acc)
+
+		switch d := naf[i]; {
+		case d > 0:
+			acc.Add(acc, table[d/2])
+		case d < 0:
+			acc.Add(acc, neg.Negate(table[-d/2]))
+		}
+	}
+
+	e.p = acc
+	e.isBase = false
+
+	return e
+}
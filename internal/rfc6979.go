@@ -0,0 +1,79 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (C) 2020-2023 Daniel Bourdrez. All Rights Reserved.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree or at
+// https://spdx.org/licenses/MIT.html
+
+package internal
+
+import (
+	"crypto"
+	"crypto/hmac"
+	"math/big"
+)
+
+// bits2int implements the bits2int transform of RFC 6979 section 2.3.2.
+func bits2int(in []byte, qlen int) *big.Int {
+	v := new(big.Int).SetBytes(in)
+	if l := len(in) * 8; l > qlen {
+		v.Rsh(v, uint(l-qlen))
+	}
+
+	return v
+}
+
+// NonceRFC6979 returns the deterministic nonce k for the big-endian private key x and message m, as specified in
+// RFC 6979 section 3.2, using the hash function h and the group order q.
+func NonceRFC6979(h crypto.Hash, q *big.Int, x, m []byte) *big.Int {
+	qlen := q.BitLen()
+	rlen := (qlen + 7) / 8
+
+	// int2octets(x) and bits2octets(H(m))
+	hm := h.New()
+	_, _ = hm.Write(m)
+	z := bits2int(hm.Sum(nil), qlen)
+	z.Mod(z, q)
+
+	bx := make([]byte, 0, 2*rlen)
+	bx = append(bx, new(big.Int).SetBytes(x).FillBytes(make([]byte, rlen))...)
+	bx = append(bx, z.FillBytes(make([]byte, rlen))...)
+
+	v := make([]byte, h.Size())
+	for i := range v {
+		v[i] = 0x01
+	}
+
+	k := make([]byte, h.Size())
+
+	mac := func(key []byte, data ...[]byte) []byte {
+		m := hmac.New(h.New, key)
+		for _, d := range data {
+			_, _ = m.Write(d)
+		}
+
+		return m.Sum(nil)
+	}
+
+	k = mac(k, v, []byte{0x00}, bx)
+	v = mac(k, v)
+	k = mac(k, v, []byte{0x01}, bx)
+	v = mac(k, v)
+
+	for {
+		t := make([]byte, 0, rlen+h.Size())
+		for len(t) < rlen {
+			v = mac(k, v)
+			t = append(t, v...)
+		}
+
+		nonce := bits2int(t, qlen)
+		if nonce.Sign() > 0 && nonce.Cmp(q) < 0 {
+			return nonce
+		}
+
+		k = mac(k, v, []byte{0x00})
+		v = mac(k, v)
+	}
+}
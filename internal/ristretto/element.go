@@ -10,6 +10,7 @@
 package ristretto
 
 import (
+	"crypto/subtle"
 	"encoding/hex"
 	"fmt"
 
@@ -62,12 +63,37 @@ func (e *Element) Double() internal.Element {
 	return e
 }
 
+// DoubleN sets the receiver to its multiplication by 2^n, and returns it. It is a no-op if n is not positive.
+func (e *Element) DoubleN(n int) internal.Element {
+	for i := 0; i < n; i++ {
+		e.element.Add(&e.element, &e.element)
+	}
+
+	return e
+}
+
 // Negate sets the receiver to its negation, and returns it.
 func (e *Element) Negate() internal.Element {
 	e.element.Negate(&e.element)
 	return e
 }
 
+// ClearCofactor is a no-op and returns the receiver, since the ristretto255 encoding enforces the prime-order
+// quotient group.
+func (e *Element) ClearCofactor() internal.Element {
+	return e
+}
+
+// IsInPrimeOrderSubgroup always returns true, since ristretto255 is a prime-order group.
+func (e *Element) IsInPrimeOrderSubgroup() bool {
+	return true
+}
+
+// Ciphersuite returns the hash-to-curve ciphersuite identifier of the element's group.
+func (e *Element) Ciphersuite() string {
+	return H2C
+}
+
 // Subtract subtracts the input from the receiver, and returns the receiver.
 func (e *Element) Subtract(element internal.Element) internal.Element {
 	ec := checkElement(element)
@@ -89,6 +115,20 @@ func (e *Element) Multiply(scalar internal.Scalar) internal.Element {
 	return e
 }
 
+// MultiplyVartime sets the receiver to the scalar multiplication of the receiver with the given Scalar, and returns
+// it. It is not constant-time, and must only be used with public scalars.
+func (e *Element) MultiplyVartime(scalar internal.Scalar) internal.Element {
+	if scalar == nil {
+		e.element.Zero()
+		return e
+	}
+
+	sc := assert(scalar)
+	e.element.VarTimeDoubleScalarBaseMult(&sc.scalar, &e.element, ristretto255.NewScalar())
+
+	return e
+}
+
 // Equal returns 1 if the elements are equivalent, and 0 otherwise.
 func (e *Element) Equal(element internal.Element) int {
 	ec := checkElement(element)
@@ -101,6 +141,12 @@ func (e *Element) IsIdentity() bool {
 	return e.element.Equal(id) == 1
 }
 
+// IsValid returns whether the element is a non-identity element of the group. Ristretto255 elements are always in the
+// prime-order group, so this only rejects the identity element.
+func (e *Element) IsValid() bool {
+	return !e.IsIdentity()
+}
+
 func (e *Element) set(element *Element) *Element {
 	*e = *element
 	return e
@@ -120,6 +166,21 @@ func (e *Element) Set(element internal.Element) internal.Element {
 	return e.set(ec)
 }
 
+// CondSet sets the receiver to the argument if cond is 1, leaves it unchanged if cond is 0, and returns the receiver.
+// The selection is done in constant time on the encodings. The behavior is undefined if cond is not 0 or 1.
+func (e *Element) CondSet(element internal.Element, cond int) internal.Element {
+	ec := checkElement(element)
+	enc := e.element.Encode(nil)
+	subtle.ConstantTimeCopy(cond, enc, ec.element.Encode(nil))
+
+	if err := e.element.Decode(enc); err != nil {
+		// This can't happen, since the encoding is that of a valid element.
+		panic(err)
+	}
+
+	return e
+}
+
 // Copy returns a copy of the receiver.
 func (e *Element) Copy() internal.Element {
 	n := ristretto255.NewElement()
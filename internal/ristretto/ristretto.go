@@ -21,6 +21,9 @@ import (
 const (
 	inputLength = 64
 
+	// securityLevel is the target security level k, in bits, of the hash-to-group suite.
+	securityLevel = 128
+
 	// H2C represents the hash-to-curve string identifier.
 	H2C = "ristretto255_XMD:SHA-512_R255MAP_RO_"
 
@@ -29,6 +32,9 @@ const (
 	// = 0x1000000000000000000000000000000014def9dea2f79cd65812631a5cf5d3ed
 	// cofactor h = 8.
 	orderPrime = "7237005577332262213973186563042994240857116359379907606001950938285454250989"
+
+	// fieldOrder represents curve25519's base field prime-order = 2^255 - 19.
+	fieldOrder = "57896044618658097711785492504343953926634992332820282019728792003956564819949"
 )
 
 // Group represents the Ristretto255 group. It exposes a prime-order group API with hash-to-curve operations.
@@ -66,6 +72,20 @@ func (g Group) HashToScalar(input, dst []byte) internal.Scalar {
 	return &Scalar{*ristretto255.NewScalar().FromUniformBytes(uniform)}
 }
 
+// HashToScalars returns count independent safe mappings of the arbitrary input to Scalars, from a single
+// expand_message_xmd output of count times the length used in HashToScalar.
+// The DST must not be empty or nil, and is recommended to be longer than 16 bytes.
+func (g Group) HashToScalars(input, dst []byte, count uint) []internal.Scalar {
+	uniform := hash2curve.ExpandXMD(crypto.SHA512, input, dst, count*inputLength)
+	scalars := make([]internal.Scalar, count)
+
+	for i := range scalars {
+		scalars[i] = &Scalar{*ristretto255.NewScalar().FromUniformBytes(uniform[i*inputLength : (i+1)*inputLength])}
+	}
+
+	return scalars
+}
+
 // HashToGroup returns a safe mapping of the arbitrary input to an Element in the Group.
 // The DST must not be empty or nil, and is recommended to be longer than 16 bytes.
 func (g Group) HashToGroup(input, dst []byte) internal.Element {
@@ -74,17 +94,44 @@ func (g Group) HashToGroup(input, dst []byte) internal.Element {
 	return &Element{*ristretto255.NewElement().FromUniformBytes(uniform)}
 }
 
+// HashToGroupXMD returns the hash function and the output length of the expand_message_xmd step of HashToGroup.
+func (g Group) HashToGroupXMD() (crypto.Hash, uint) {
+	return crypto.SHA512, inputLength
+}
+
+// HashUniformToGroup maps the output of the expand_message_xmd step of HashToGroup to an Element in the Group, using
+// the ristretto255 one-way map.
+func (g Group) HashUniformToGroup(uniform []byte) internal.Element {
+	return &Element{*ristretto255.NewElement().FromUniformBytes(uniform)}
+}
+
 // EncodeToGroup returns a non-uniform mapping of the arbitrary input to an Element in the Group.
 // The DST must not be empty or nil, and is recommended to be longer than 16 bytes.
 func (g Group) EncodeToGroup(input, dst []byte) internal.Element {
 	return g.HashToGroup(input, dst)
 }
 
+// MapToCurve returns the deterministic mapping of the canonically encoded field element to an Element in the
+// Group, using the ristretto255 MAP function. Since the one-way map sums MAP over both halves of its input, and the
+// MAP of zero is the identity, this is the one-way map over the field element followed by 32 zero bytes.
+func (g Group) MapToCurve(fe []byte) internal.Element {
+	uniform := make([]byte, inputLength)
+	copy(uniform, fe)
+
+	return &Element{*ristretto255.NewElement().FromUniformBytes(uniform)}
+}
+
 // Ciphersuite returns the hash-to-curve ciphersuite identifier.
 func (g Group) Ciphersuite() string {
 	return H2C
 }
 
+// CiphersuiteNU returns the encode-to-curve ciphersuite identifier. Ristretto255 has no encode-to-curve suite, and
+// EncodeToGroup uses the hash-to-curve one, so this is the same as Ciphersuite.
+func (g Group) CiphersuiteNU() string {
+	return H2C
+}
+
 // ScalarLength returns the byte size of an encoded element.
 func (g Group) ScalarLength() int {
 	return canonicalEncodingLength
@@ -99,3 +146,19 @@ func (g Group) ElementLength() int {
 func (g Group) Order() string {
 	return orderPrime
 }
+
+// FieldOrder returns the order of the base field the curve is defined over.
+func (g Group) FieldOrder() string {
+	return fieldOrder
+}
+
+// SecLength returns the byte length of the uniform string expanded for each scalar. Ristretto255 has no hash-to-field
+// step, and reduces or maps a single 64-byte uniform string instead.
+func (g Group) SecLength() uint {
+	return inputLength
+}
+
+// SecurityLevel returns the target security level k of the hash-to-group suite, in bits.
+func (g Group) SecurityLevel() uint {
+	return securityLevel
+}
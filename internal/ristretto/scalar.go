@@ -10,10 +10,13 @@
 package ristretto
 
 import (
+	"bytes"
+	"crypto/subtle"
 	"encoding/binary"
 	"encoding/hex"
 	"fmt"
 	"math/big"
+	"slices"
 
 	"github.com/gtank/ristretto255"
 
@@ -97,6 +100,12 @@ func (s *Scalar) Add(scalar internal.Scalar) internal.Scalar {
 	return s
 }
 
+// Double sets the receiver to its double, and returns the receiver.
+func (s *Scalar) Double() internal.Scalar {
+	s.scalar.Add(&s.scalar, &s.scalar)
+	return s
+}
+
 // Subtract subtracts the input from the receiver, and returns the receiver.
 func (s *Scalar) Subtract(scalar internal.Scalar) internal.Scalar {
 	if scalar == nil {
@@ -109,6 +118,12 @@ func (s *Scalar) Subtract(scalar internal.Scalar) internal.Scalar {
 	return s
 }
 
+// Negate sets the receiver to its additive inverse, and returns the receiver.
+func (s *Scalar) Negate() internal.Scalar {
+	s.scalar.Subtract(&scZero.scalar, &s.scalar)
+	return s
+}
+
 func (s *Scalar) multiply(scalar *Scalar) {
 	s.scalar.Multiply(&s.scalar, &scalar.scalar)
 }
@@ -125,6 +140,16 @@ func (s *Scalar) Multiply(scalar internal.Scalar) internal.Scalar {
 	return s
 }
 
+// MulAdd sets the receiver to receiver * b + c, and returns the receiver. The arguments can alias the receiver.
+func (s *Scalar) MulAdd(b, c internal.Scalar) internal.Scalar {
+	var product ristretto255.Scalar
+
+	product.Multiply(&s.scalar, &assert(b).scalar)
+	s.scalar.Add(&product, &assert(c).scalar)
+
+	return s
+}
+
 func getMSBit(in byte) int {
 	for i := 7; i >= 0; i-- {
 		mask := byte(1 << uint(i))
@@ -200,7 +225,7 @@ func (s *Scalar) Pow(scalar internal.Scalar) internal.Scalar {
 	return s
 }
 
-// Invert sets the receiver to the scalar's modular inverse ( 1 / scalar ), and returns it.
+// Invert sets the receiver to the scalar's modular inverse ( 1 / scalar ), and returns it. 0 is left unchanged.
 func (s *Scalar) Invert() internal.Scalar {
 	s.scalar.Invert(&s.scalar)
 	return s
@@ -217,6 +242,18 @@ func (s *Scalar) Equal(scalar internal.Scalar) int {
 	return s.scalar.Equal(&sc.scalar)
 }
 
+// Cmp returns -1 if s < scalar, 0 if s == scalar, and 1 if s > scalar, comparing their numeric values from the
+// little-endian encodings. It is not constant-time.
+func (s *Scalar) Cmp(scalar internal.Scalar) int {
+	sc := assert(scalar)
+
+	a, b := s.Encode(), sc.Encode()
+	slices.Reverse(a)
+	slices.Reverse(b)
+
+	return bytes.Compare(a, b)
+}
+
 // LessOrEqual returns 1 if s <= scalar and 0 otherwise.
 func (s *Scalar) LessOrEqual(scalar internal.Scalar) int {
 	sc := assert(scalar)
@@ -247,6 +284,21 @@ func (s *Scalar) IsZero() bool {
 	return s.scalar.Equal(&scZero.scalar) == 1
 }
 
+// IsOne returns whether the scalar is 1.
+func (s *Scalar) IsOne() bool {
+	return s.scalar.Equal(&scOne.scalar) == 1
+}
+
+// IsLittleEndian returns whether the scalar's encoding is in little-endian.
+func (s *Scalar) IsLittleEndian() bool {
+	return true
+}
+
+// Ciphersuite returns the hash-to-curve ciphersuite identifier of the scalar's group.
+func (s *Scalar) Ciphersuite() string {
+	return H2C
+}
+
 // Set sets the receiver to the value of the argument scalar, and returns the receiver.
 func (s *Scalar) Set(scalar internal.Scalar) internal.Scalar {
 	if scalar == nil {
@@ -260,6 +312,20 @@ func (s *Scalar) Set(scalar internal.Scalar) internal.Scalar {
 	return s
 }
 
+// CondSet sets the receiver to the argument if cond is 1, leaves it unchanged if cond is 0, and returns the receiver.
+// The selection is done in constant time on the encodings. The behavior is undefined if cond is not 0 or 1.
+func (s *Scalar) CondSet(scalar internal.Scalar, cond int) internal.Scalar {
+	sc := assert(scalar)
+	enc := s.Encode()
+	subtle.ConstantTimeCopy(cond, enc, sc.Encode())
+
+	if err := s.Decode(enc); err != nil {
+		panic(err)
+	}
+
+	return s
+}
+
 // SetUInt64 sets s to i modulo the field order, and returns an error if one occurs.
 func (s *Scalar) SetUInt64(i uint64) internal.Scalar {
 	encoded := make([]byte, canonicalEncodingLength)
@@ -302,7 +368,7 @@ func (s *Scalar) Copy() internal.Scalar {
 	return s.copy()
 }
 
-// Encode returns the compressed byte encoding of the scalar.
+// Encode returns the compressed byte encoding of the scalar. Appending to a nil slice allocates a new one on each call.
 func (s *Scalar) Encode() []byte {
 	return s.scalar.Encode(nil)
 }
@@ -324,6 +390,18 @@ func decodeScalar(scalar []byte) (*ristretto255.Scalar, error) {
 	return s, nil
 }
 
+// SetWideBytes sets the receiver to the reduction modulo the group order of the 64-byte little-endian input, and
+// returns an error on failure.
+func (s *Scalar) SetWideBytes(in []byte) error {
+	if len(in) != inputLength {
+		return internal.ErrParamScalarLength
+	}
+
+	s.scalar.FromUniformBytes(in)
+
+	return nil
+}
+
 // Decode sets the receiver to a decoding of the input data, and returns an error on failure.
 func (s *Scalar) Decode(in []byte) error {
 	sc, err := decodeScalar(in)
@@ -24,16 +24,25 @@ type Scalar interface {
 	// Add sets the receiver to the sum of the input and the receiver, and returns the receiver.
 	Add(Scalar) Scalar
 
+	// Double sets the receiver to its double, and returns the receiver.
+	Double() Scalar
+
 	// Subtract subtracts the input from the receiver, and returns the receiver.
 	Subtract(Scalar) Scalar
 
+	// Negate sets the receiver to its additive inverse, and returns the receiver.
+	Negate() Scalar
+
 	// Multiply multiplies the receiver with the input, and returns the receiver.
 	Multiply(Scalar) Scalar
 
+	// MulAdd sets the receiver to receiver * b + c, and returns the receiver. The arguments can alias the receiver.
+	MulAdd(b, c Scalar) Scalar
+
 	// Pow sets s to s**scalar modulo the group order, and returns s. If scalar is nil, it returns 1.
 	Pow(scalar Scalar) Scalar
 
-	// Invert sets the receiver to the scalar's modular inverse ( 1 / scalar ), and returns it.
+	// Invert sets the receiver to the scalar's modular inverse ( 1 / scalar ), and returns it. 0 is left unchanged.
 	Invert() Scalar
 
 	// Equal returns 1 if the scalars are equal, and 0 otherwise.
@@ -42,12 +51,28 @@ type Scalar interface {
 	// LessOrEqual returns 1 if s <= scalar, and 0 otherwise.
 	LessOrEqual(scalar Scalar) int
 
+	// Cmp returns -1 if s < scalar, 0 if s == scalar, and 1 if s > scalar. It is not constant-time.
+	Cmp(scalar Scalar) int
+
 	// IsZero returns whether the scalar is 0.
 	IsZero() bool
 
+	// IsOne returns whether the scalar is 1.
+	IsOne() bool
+
+	// IsLittleEndian returns whether the scalar's encoding is in little-endian.
+	IsLittleEndian() bool
+
+	// Ciphersuite returns the hash-to-curve ciphersuite identifier of the scalar's group.
+	Ciphersuite() string
+
 	// Set sets the receiver to the value of the argument scalar, and returns the receiver.
 	Set(Scalar) Scalar
 
+	// CondSet sets the receiver to the argument if cond is 1, leaves it unchanged if cond is 0, and returns the
+	// receiver, in constant time.
+	CondSet(scalar Scalar, cond int) Scalar
+
 	// SetUInt64 sets s to i modulo the field order, and returns an error if one occurs.
 	SetUInt64(i uint64) Scalar
 
@@ -58,12 +83,16 @@ type Scalar interface {
 	// Copy returns a copy of the receiver.
 	Copy() Scalar
 
-	// Encode returns the compressed byte encoding of the scalar.
+	// Encode returns the compressed byte encoding of the scalar, in a new slice the caller can modify.
 	Encode() []byte
 
 	// Decode sets the receiver to a decoding of the input data, and returns an error on failure.
 	Decode(in []byte) error
 
+	// SetWideBytes sets the receiver to the reduction modulo the group order of the input of twice the scalar length,
+	// in the byte order of the scalar encoding, and returns an error on failure.
+	SetWideBytes(in []byte) error
+
 	// Hex returns the fixed-sized hexadecimal encoding of s.
 	Hex() string
 
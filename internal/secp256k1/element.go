@@ -9,14 +9,21 @@
 package secp256k1
 
 import (
+	"crypto/subtle"
 	"encoding/hex"
 	"fmt"
+	"math/big"
 
 	"github.com/bytemare/secp256k1"
 
 	"github.com/bytemare/crypto/internal"
 )
 
+const uncompressedLength = 2*elementLength - 1
+
+// curveB is the constant b of the curve equation y^2 = x^3 + b.
+var curveB = big.NewInt(7)
+
 // Element implements the Element interface for the Secp256k1 group element.
 type Element struct {
 	element *secp256k1.Element
@@ -66,12 +73,47 @@ func (e *Element) Double() internal.Element {
 	return e
 }
 
+// DoubleN sets the receiver to its multiplication by 2^n, and returns it. It is a no-op if n is not positive.
+func (e *Element) DoubleN(n int) internal.Element {
+	for i := 0; i < n; i++ {
+		e.element.Double()
+	}
+
+	return e
+}
+
 // Negate sets the receiver to its negation, and returns it.
 func (e *Element) Negate() internal.Element {
-	e.element.Negate()
+	if e.element.IsIdentity() {
+		return e
+	}
+
+	// The underlying negation sets y to -y without reducing it modulo p, which is only correct for points in projective
+	// form: for affine points, e.g. freshly decoded ones, the negative y is used as is by Encode and Equal. Since the
+	// coordinates of the underlying element are not accessible, P - 2P is computed instead, whose complete addition
+	// formulas reduce all coordinates. This is much cheaper than re-decoding the encoding with the other y parity,
+	// which requires a square root.
+	d := e.element.Copy().Double()
+	e.element.Subtract(d)
+
+	return e
+}
+
+// ClearCofactor is a no-op and returns the receiver, since the cofactor is 1.
+func (e *Element) ClearCofactor() internal.Element {
 	return e
 }
 
+// IsInPrimeOrderSubgroup always returns true, since the cofactor is 1.
+func (e *Element) IsInPrimeOrderSubgroup() bool {
+	return true
+}
+
+// Ciphersuite returns the hash-to-curve ciphersuite identifier of the element's group.
+func (e *Element) Ciphersuite() string {
+	return H2CSECP256K1
+}
+
 // Subtract subtracts the input from the receiver, and returns the receiver.
 func (e *Element) Subtract(element internal.Element) internal.Element {
 	q := assertElement(element)
@@ -88,10 +130,11 @@ func (e *Element) Multiply(scalar internal.Scalar) internal.Element {
 	return e
 }
 
-// Equal returns 1 if the elements are equivalent, and 0 otherwise.
+// Equal returns 1 if the elements are equivalent, and 0 otherwise. The underlying library compares the affine
+// coordinates with big.Int.Cmp, which is not constant-time, so the fixed-length encodings are compared instead.
 func (e *Element) Equal(element internal.Element) int {
 	q := assertElement(element)
-	return e.element.Equal(q.element)
+	return subtle.ConstantTimeCompare(e.element.Encode(), q.element.Encode())
 }
 
 // IsIdentity returns whether the Element is the point at infinity of the Group's underlying curve.
@@ -99,6 +142,22 @@ func (e *Element) IsIdentity() bool {
 	return e.element.IsIdentity()
 }
 
+// IsValid returns whether the element is a non-identity point on the curve. Secp256k1 has a cofactor of 1, so all
+// such points are in the prime-order group. Since decoding the x-coordinate recovers the y-coordinate from the curve
+// equation, the element is on the curve if and only if it equals the decoding of its own encoding.
+func (e *Element) IsValid() bool {
+	if e.element.IsIdentity() {
+		return false
+	}
+
+	q := secp256k1.NewElement()
+	if err := q.Decode(e.element.Encode()); err != nil {
+		return false
+	}
+
+	return q.Equal(e.element) == 1
+}
+
 // Set sets the receiver to the value of the argument, and returns the receiver.
 func (e *Element) Set(element internal.Element) internal.Element {
 	if element == nil {
@@ -111,6 +170,23 @@ func (e *Element) Set(element internal.Element) internal.Element {
 	return e
 }
 
+// CondSet sets the receiver to the argument if cond is 1, leaves it unchanged if cond is 0, and returns the receiver.
+// The selection is done in constant time on the encodings, but the underlying arithmetic is not constant-time, and the
+// decoding of the result branches on whether it is the identity element. The behavior is undefined if cond is not 0 or
+// 1.
+func (e *Element) CondSet(element internal.Element, cond int) internal.Element {
+	q := assertElement(element)
+	enc := e.element.Encode()
+	subtle.ConstantTimeCopy(cond, enc, q.element.Encode())
+
+	if err := e.element.Decode(enc); err != nil {
+		// The identity element, encoded as zeroes, is the only valid element failing to decode.
+		e.element.Identity()
+	}
+
+	return e
+}
+
 // Copy returns a copy of the receiver.
 func (e *Element) Copy() internal.Element {
 	return &Element{element: e.element.Copy()}
@@ -135,6 +211,62 @@ func (e *Element) Decode(data []byte) error {
 	return nil
 }
 
+// EncodeUncompressed returns the SEC1 uncompressed encoding of the element, i.e. 0x04 || X || Y. The identity element
+// is encoded as a zero-filled slice of the same length.
+func (e *Element) EncodeUncompressed() []byte {
+	out := make([]byte, uncompressedLength)
+	if e.element.IsIdentity() {
+		return out
+	}
+
+	enc := e.element.Encode()
+	y := curveY(new(big.Int).SetBytes(enc[1:]))
+
+	if y.Bit(0) != uint(enc[0]&1) {
+		y.Sub(fp, y)
+	}
+
+	out[0] = 4
+	copy(out[1:elementLength], enc[1:])
+	y.FillBytes(out[elementLength:])
+
+	return out
+}
+
+// DecodeUncompressed sets the receiver to the decoding of the SEC1 uncompressed encoding, and returns an error on
+// failure.
+func (e *Element) DecodeUncompressed(data []byte) error {
+	if len(data) != uncompressedLength || data[0] != 4 {
+		return internal.ErrParamInvalidPointEncoding
+	}
+
+	x := new(big.Int).SetBytes(data[1:elementLength])
+	y := new(big.Int).SetBytes(data[elementLength:])
+
+	if x.Cmp(fp) >= 0 || y.Cmp(fp) >= 0 {
+		return internal.ErrParamInvalidPointEncoding
+	}
+
+	// Check that the point is on the curve, i.e. that y is one of the two roots for x.
+	if root := curveY(x); root == nil || (root.Cmp(y) != 0 && root.Add(root, y).Cmp(fp) != 0) {
+		return internal.ErrParamInvalidPointEncoding
+	}
+
+	enc := make([]byte, elementLength)
+	enc[0] = byte(2 | y.Bit(0))
+	copy(enc[1:], data[1:elementLength])
+
+	return e.Decode(enc)
+}
+
+// curveY returns a square root of x^3 + 7, i.e. one of the y-coordinates for x, or nil if x is not on the curve.
+func curveY(x *big.Int) *big.Int {
+	y2 := new(big.Int).Exp(x, big.NewInt(3), fp)
+	y2.Add(y2, curveB)
+
+	return y2.ModSqrt(y2.Mod(y2, fp), fp)
+}
+
 // Hex returns the fixed-sized hexadecimal encoding of e.
 func (e *Element) Hex() string {
 	return hex.EncodeToString(e.Encode())
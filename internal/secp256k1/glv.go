@@ -0,0 +1,130 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (C) 2020-2023 Daniel Bourdrez. All Rights Reserved.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree or at
+// https://spdx.org/licenses/MIT.html
+
+package secp256k1
+
+import (
+	"math/big"
+
+	"github.com/bytemare/secp256k1"
+
+	"github.com/bytemare/crypto/internal"
+)
+
+// The GLV endomorphism of secp256k1 maps (x, y) to (beta * x, y) = lambda * (x, y), where beta and lambda are
+// non-trivial cube roots of unity in the base and scalar fields, respectively, with
+// lambda = 0x5363ad4cc05c30e0a5261c028812645a122e22ea20816678df02967c1b23bd72. The lattice basis (a1, b1), (a2, b2)
+// is used to decompose a scalar k into k1 + k2 * lambda with k1 and k2 of about half the size of k.
+var (
+	glvBeta = setHex("7ae96a2b657c07106e64479eac3434e99cf0497512f58995c1396c28719501ee")
+	glvA1   = setHex("3086d221a7d46bcde86c90e49284eb15")
+	glvB1   = new(big.Int).Neg(setHex("e4437ed6010e88286f547fa90abfe4c3"))
+	glvA2   = setHex("114ca50f7a8e2f3f657c1108d9d44cfd8")
+	glvB2   = glvA1
+)
+
+func setHex(s string) *big.Int {
+	i, _ := new(big.Int).SetString(s, 16)
+	return i
+}
+
+// roundDiv returns the rounded quotient of the non-negative x by the positive d.
+func roundDiv(x, d *big.Int) *big.Int {
+	q := new(big.Int).Rsh(d, 1)
+	q.Add(q, x)
+
+	return q.Quo(q, d)
+}
+
+// decomposeGLV returns k1 and k2 such that k = k1 + k2 * lambda mod n, where |k1| and |k2| are about 128 bits.
+func decomposeGLV(k *big.Int) (k1, k2 *big.Int) {
+	c1 := roundDiv(new(big.Int).Mul(glvB2, k), fn)
+	c2 := roundDiv(new(big.Int).Mul(new(big.Int).Neg(glvB1), k), fn)
+
+	// k1 = k - c1*a1 - c2*a2
+	k1 = new(big.Int).Sub(k, new(big.Int).Mul(c1, glvA1))
+	k1.Sub(k1, new(big.Int).Mul(c2, glvA2))
+
+	// k2 = -c1*b1 - c2*b2
+	k2 = new(big.Int).Mul(c1, glvB1)
+	k2.Neg(k2)
+	k2.Sub(k2, new(big.Int).Mul(c2, glvB2))
+
+	return k1, k2
+}
+
+// endomorphism returns (beta * x, y) for the non-identity element (x, y), or its negation (beta * x, -y) if negate is
+// true.
+func endomorphism(e *secp256k1.Element, negate bool) *secp256k1.Element {
+	enc := e.Encode()
+	x := new(big.Int).SetBytes(enc[1:])
+	x.Mul(x, glvBeta)
+	x.Mod(x, fp)
+	x.FillBytes(enc[1:])
+
+	return decodeWithParity(enc, negate)
+}
+
+// decodeWithParity decodes the compressed encoding of a non-identity element, after flipping the parity of its
+// y-coordinate if negate is true. This is used instead of Negate, which does not reduce the y-coordinate.
+func decodeWithParity(enc []byte, negate bool) *secp256k1.Element {
+	if negate {
+		enc[0] ^= 1
+	}
+
+	q := secp256k1.NewElement()
+	if err := q.Decode(enc); err != nil {
+		// This can't happen, since the encoding is that of a valid point or its endomorphism.
+		panic(err)
+	}
+
+	return q
+}
+
+// MultiplyVartime sets the receiver to the scalar multiplication of the receiver with the given Scalar, and returns
+// it. It uses the GLV endomorphism to split the scalar into two half-sized scalars, and interleaves both
+// multiplications. This is not constant-time, and must only be used with public scalars.
+func (e *Element) MultiplyVartime(scalar internal.Scalar) internal.Element {
+	s := assert(scalar)
+	k := new(big.Int).SetBytes(s.scalar.Encode())
+
+	if k.Sign() == 0 || e.element.IsIdentity() {
+		e.element.Identity()
+		return e
+	}
+
+	k1, k2 := decomposeGLV(k)
+
+	p1 := e.element.Copy()
+	if k1.Sign() < 0 {
+		p1 = decodeWithParity(e.element.Encode(), true)
+		k1.Neg(k1)
+	}
+
+	p2 := endomorphism(e.element, k2.Sign() < 0)
+	k2.Abs(k2)
+	p12 := p1.Copy().Add(p2)
+
+	acc := secp256k1.NewElement()
+	for i := max(k1.BitLen(), k2.BitLen()) - 1; i >= 0; i-- {
+		acc.Double()
+
+		switch k1.Bit(i) | k2.Bit(i)<<1 {
+		case 1:
+			acc.Add(p1)
+		case 2:
+			acc.Add(p2)
+		case 3:
+			acc.Add(p12)
+		}
+	}
+
+	e.element.Set(acc)
+
+	return e
+}
@@ -11,7 +11,9 @@ package secp256k1
 
 import (
 	"crypto"
+	"math/big"
 
+	"github.com/bytemare/hash2curve"
 	"github.com/bytemare/secp256k1"
 
 	"github.com/bytemare/crypto/internal"
@@ -27,6 +29,8 @@ const (
 	groupOrder    = "115792089237316195423570985008687907852837564279074904382605163141518161494337"
 	scalarLength  = 32
 	elementLength = 33
+	secLength     = 48
+	securityLevel = 128
 )
 
 // Group represents the Secp256k1 group. It exposes a prime-order group API with hash-to-curve operations.
@@ -63,23 +67,96 @@ func (g Group) HashToScalar(input, dst []byte) internal.Scalar {
 	return &Scalar{scalar: secp256k1.HashToScalar(input, dst)}
 }
 
+// HashToScalars returns count independent safe mappings of the arbitrary input to Scalars, from a single
+// hash_to_field expansion.
+// The DST must not be empty or nil, and is recommended to be longer than 16 bytes.
+func (g Group) HashToScalars(input, dst []byte, count uint) []internal.Scalar {
+	fields := hash2curve.HashToFieldXMD(crypto.SHA256, input, dst, count, 1, secLength, fn)
+	scalars := make([]internal.Scalar, count)
+
+	for i, f := range fields {
+		s := newScalar()
+		if err := s.Decode(f.FillBytes(make([]byte, scalarLength))); err != nil {
+			panic(err)
+		}
+
+		scalars[i] = s
+	}
+
+	return scalars
+}
+
 // HashToGroup returns a safe mapping of the arbitrary input to an Element in the Group.
 // The DST must not be empty or nil, and is recommended to be longer than 16 bytes.
 func (g Group) HashToGroup(input, dst []byte) internal.Element {
 	return &Element{element: secp256k1.HashToGroup(input, dst)}
 }
 
+// HashToGroupWithSecLength is like HashToGroup, but uses secLength as the security length L of the hash-to-field
+// step, which must not be lower than SecLength.
+func (g Group) HashToGroupWithSecLength(input, dst []byte, secLength uint) internal.Element {
+	u := hash2curve.HashToFieldXMD(crypto.SHA256, input, dst, 2, 1, secLength, fp)
+	q := mapToCurve(u[0])
+	q.element.Add(mapToCurve(u[1]).element)
+
+	return q
+}
+
+// HashToGroupIntermediate returns the output of HashToGroup, and the outputs Q0 and Q1 of map_to_curve it sums.
+func (g Group) HashToGroupIntermediate(input, dst []byte) (p, q0, q1 internal.Element) {
+	u := hash2curve.HashToFieldXMD(crypto.SHA256, input, dst, 2, 1, secLength, fp)
+	m0, m1 := mapToCurve(u[0]), mapToCurve(u[1])
+
+	return m0.Copy().Add(m1), m0, m1
+}
+
+// SecLength returns the default security length L of the hash-to-field step, in bytes.
+func (g Group) SecLength() uint {
+	return secLength
+}
+
+// SecurityLevel returns the target security level k of the hash-to-curve suite, in bits.
+func (g Group) SecurityLevel() uint {
+	return securityLevel
+}
+
+// HashToGroupXMD returns the hash function and the output length of the expand_message_xmd step of HashToGroup.
+func (g Group) HashToGroupXMD() (crypto.Hash, uint) {
+	return crypto.SHA256, 2 * secLength
+}
+
+// HashUniformToGroup maps the output of the expand_message_xmd step of HashToGroup to an Element in the Group.
+func (g Group) HashUniformToGroup(uniform []byte) internal.Element {
+	u0 := new(big.Int).SetBytes(uniform[:secLength])
+	u1 := new(big.Int).SetBytes(uniform[secLength:])
+	q := mapToCurve(u0.Mod(u0, fp))
+	q.element.Add(mapToCurve(u1.Mod(u1, fp)).element)
+
+	return q
+}
+
 // EncodeToGroup returns a non-uniform mapping of the arbitrary input to an Element in the Group.
 // The DST must not be empty or nil, and is recommended to be longer than 16 bytes.
 func (g Group) EncodeToGroup(input, dst []byte) internal.Element {
 	return &Element{element: secp256k1.EncodeToGroup(input, dst)}
 }
 
+// MapToCurve returns the deterministic mapping of the canonically encoded field element to an Element in the
+// Group, using the map of the hash-to-curve suite and with cofactor clearing.
+func (g Group) MapToCurve(fe []byte) internal.Element {
+	return mapToCurve(new(big.Int).SetBytes(fe))
+}
+
 // Ciphersuite returns the hash-to-curve ciphersuite identifier.
 func (g Group) Ciphersuite() string {
 	return H2CSECP256K1
 }
 
+// CiphersuiteNU returns the encode-to-curve ciphersuite identifier.
+func (g Group) CiphersuiteNU() string {
+	return E2CSECP256K1
+}
+
 // ScalarLength returns the byte size of an encoded scalar.
 func (g Group) ScalarLength() int {
 	return scalarLength
@@ -94,3 +171,8 @@ func (g Group) ElementLength() int {
 func (g Group) Order() string {
 	return groupOrder
 }
+
+// FieldOrder returns the order of the base field the curve is defined over.
+func (g Group) FieldOrder() string {
+	return fieldOrder
+}
@@ -0,0 +1,39 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (C) 2020-2023 Daniel Bourdrez. All Rights Reserved.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree or at
+// https://spdx.org/licenses/MIT.html
+
+package secp256k1
+
+import (
+	"math/big"
+
+	"github.com/bytemare/hash2curve"
+)
+
+// The parameters of the curve isogenous to secp256k1, and the Z constant of the SSWU map, from RFC 9380 section 8.7.
+var (
+	isoA = setHex("3f8731abdd661adca08a5558f0f5d272e953d363cb6f0e5d405447c01a444533")
+	isoB = big.NewInt(1771)
+	mapZ = new(big.Int).Sub(fp, big.NewInt(11))
+)
+
+// mapToCurve implements the secp256k1 map_to_curve of RFC 9380, i.e. the simplified SWU map to the isogenous curve,
+// followed by the 3-isogeny map to secp256k1. Cofactor clearing is not necessary since it is 1.
+func mapToCurve(u *big.Int) *Element {
+	x, y := hash2curve.MapToCurveSSWU(isoA, isoB, mapZ, u, fp)
+
+	px, py, isIdentity := hash2curve.IsogenySecp256k13iso(x, y)
+	if isIdentity {
+		return newElement()
+	}
+
+	var enc [elementLength]byte
+	enc[0] = byte(2 | py.Bit(0))
+	px.FillBytes(enc[1:])
+
+	return &Element{element: decodeWithParity(enc[:], false)}
+}
@@ -0,0 +1,95 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (C) 2020-2023 Daniel Bourdrez. All Rights Reserved.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree or at
+// https://spdx.org/licenses/MIT.html
+
+package secp256k1
+
+import (
+	"errors"
+	"math/big"
+
+	"github.com/bytemare/crypto/internal"
+)
+
+const (
+	fieldOrder = "115792089237316195423570985008687907853269984665640564039457584007908834671663"
+
+	maxRecoveryID = 3
+)
+
+var (
+	// ErrRecoveryID indicates an invalid ECDSA public key recovery identifier.
+	ErrRecoveryID = errors.New("invalid recovery id")
+
+	// ErrRecoveryFailed indicates that no valid public key can be recovered from the signature.
+	ErrRecoveryFailed = errors.New("public key recovery failed")
+
+	fp, _ = new(big.Int).SetString(fieldOrder, 10)
+	fn, _ = new(big.Int).SetString(groupOrder, 10)
+)
+
+// hashToInt converts the message hash to an integer modulo the group order, following SEC 1 v2 section 4.1.3,
+// i.e. by only keeping the leftmost bits of the hash if it is longer than the order.
+func hashToInt(msgHash []byte) *Scalar {
+	if len(msgHash) > scalarLength {
+		msgHash = msgHash[:scalarLength]
+	}
+
+	e := new(big.Int).SetBytes(msgHash)
+	e.Mod(e, fn)
+
+	s := newScalar()
+	if err := s.Decode(e.FillBytes(make([]byte, scalarLength))); err != nil {
+		panic(err)
+	}
+
+	return s
+}
+
+// RecoverPublicKey returns the public key Q = r^-1 * (s*R - e*G) recovered from the ECDSA signature (r, s) over
+// msgHash, as specified in SEC 1 v2 section 4.1.6. The recoveryID bit 0 indicates the parity of R's y-coordinate, and
+// bit 1 whether R's x-coordinate is r + n.
+func RecoverPublicKey(r, s internal.Scalar, recoveryID int, msgHash []byte) (internal.Element, error) {
+	if recoveryID < 0 || recoveryID > maxRecoveryID {
+		return nil, ErrRecoveryID
+	}
+
+	rs, ss := assert(r), assert(s)
+	if rs.IsZero() || ss.IsZero() {
+		return nil, ErrRecoveryFailed
+	}
+
+	x := new(big.Int).SetBytes(rs.Encode())
+	if recoveryID&2 != 0 {
+		x.Add(x, fn)
+	}
+
+	if x.Cmp(fp) >= 0 {
+		return nil, ErrRecoveryFailed
+	}
+
+	encoded := make([]byte, elementLength)
+	encoded[0] = byte(2 | recoveryID&1)
+	x.FillBytes(encoded[1:])
+
+	point := newElement()
+	if err := point.Decode(encoded); err != nil {
+		return nil, ErrRecoveryFailed
+	}
+
+	// Q = r^-1 * (s*R - e*G)
+	e := hashToInt(msgHash)
+	eG := newElement().Base().Multiply(e)
+	point.Multiply(ss).Subtract(eG)
+	point.Multiply(rs.Copy().Invert())
+
+	if point.IsIdentity() {
+		return nil, ErrRecoveryFailed
+	}
+
+	return point, nil
+}
@@ -9,8 +9,11 @@
 package secp256k1
 
 import (
+	"bytes"
+	"crypto/subtle"
 	"encoding/binary"
 	"fmt"
+	"math/big"
 
 	"github.com/bytemare/secp256k1"
 
@@ -66,6 +69,12 @@ func (s *Scalar) Add(scalar internal.Scalar) internal.Scalar {
 	return s
 }
 
+// Double sets the receiver to its double, and returns the receiver.
+func (s *Scalar) Double() internal.Scalar {
+	s.scalar.Add(s.scalar)
+	return s
+}
+
 // Subtract subtracts the input from the receiver, and returns the receiver.
 func (s *Scalar) Subtract(scalar internal.Scalar) internal.Scalar {
 	if scalar == nil {
@@ -78,6 +87,15 @@ func (s *Scalar) Subtract(scalar internal.Scalar) internal.Scalar {
 	return s
 }
 
+// Negate sets the receiver to its additive inverse, and returns the receiver.
+// The library has no negation, so the receiver is subtracted from a new zero scalar.
+func (s *Scalar) Negate() internal.Scalar {
+	neg := secp256k1.NewScalar().Subtract(s.scalar)
+	s.scalar.Set(neg)
+
+	return s
+}
+
 // Multiply multiplies the receiver with the input, and returns the receiver.
 func (s *Scalar) Multiply(scalar internal.Scalar) internal.Scalar {
 	if scalar == nil {
@@ -90,6 +108,14 @@ func (s *Scalar) Multiply(scalar internal.Scalar) internal.Scalar {
 	return s
 }
 
+// MulAdd sets the receiver to receiver * b + c, and returns the receiver. The arguments can alias the receiver.
+func (s *Scalar) MulAdd(b, c internal.Scalar) internal.Scalar {
+	addend := assert(c).scalar.Copy()
+	s.scalar.Multiply(assert(b).scalar).Add(addend)
+
+	return s
+}
+
 // Pow sets s to s**scalar modulo the group order, and returns s. If scalar is nil, it returns 1.
 func (s *Scalar) Pow(scalar internal.Scalar) internal.Scalar {
 	if scalar == nil || scalar.IsZero() {
@@ -106,7 +132,8 @@ func (s *Scalar) Pow(scalar internal.Scalar) internal.Scalar {
 	return s
 }
 
-// Invert sets the receiver to its modular inverse ( 1 / s ), and returns it.
+// Invert sets the receiver to its modular inverse ( 1 / s ), and returns it. Since this uses Fermat's little theorem,
+// 0 is left unchanged.
 func (s *Scalar) Invert() internal.Scalar {
 	s.scalar.Invert()
 	return s
@@ -123,6 +150,13 @@ func (s *Scalar) Equal(scalar internal.Scalar) int {
 	return s.scalar.Equal(sc.scalar)
 }
 
+// Cmp returns -1 if s < scalar, 0 if s == scalar, and 1 if s > scalar, comparing their big-endian encodings. It is not
+// constant-time.
+func (s *Scalar) Cmp(scalar internal.Scalar) int {
+	sc := assert(scalar)
+	return bytes.Compare(s.scalar.Encode(), sc.scalar.Encode())
+}
+
 // LessOrEqual returns 1 if s <= scalar and 0 otherwise.
 func (s *Scalar) LessOrEqual(scalar internal.Scalar) int {
 	sc := assert(scalar)
@@ -134,6 +168,25 @@ func (s *Scalar) IsZero() bool {
 	return s.scalar.IsZero()
 }
 
+// IsOne returns whether the scalar is 1, comparing the fixed-length encodings in constant time.
+func (s *Scalar) IsOne() bool {
+	enc := s.scalar.Encode()
+	one := make([]byte, len(enc))
+	one[len(one)-1] = 1
+
+	return subtle.ConstantTimeCompare(enc, one) == 1
+}
+
+// IsLittleEndian returns whether the scalar's encoding is in little-endian.
+func (s *Scalar) IsLittleEndian() bool {
+	return false
+}
+
+// Ciphersuite returns the hash-to-curve ciphersuite identifier of the scalar's group.
+func (s *Scalar) Ciphersuite() string {
+	return H2CSECP256K1
+}
+
 // Set sets the receiver to the value of the argument scalar, and returns the receiver.
 func (s *Scalar) Set(scalar internal.Scalar) internal.Scalar {
 	if scalar == nil {
@@ -146,6 +199,20 @@ func (s *Scalar) Set(scalar internal.Scalar) internal.Scalar {
 	return s
 }
 
+// CondSet sets the receiver to the argument if cond is 1, leaves it unchanged if cond is 0, and returns the receiver.
+// The selection is done in constant time on the encodings. The behavior is undefined if cond is not 0 or 1.
+func (s *Scalar) CondSet(scalar internal.Scalar, cond int) internal.Scalar {
+	sc := assert(scalar)
+	enc := s.Encode()
+	subtle.ConstantTimeCopy(cond, enc, sc.Encode())
+
+	if err := s.Decode(enc); err != nil {
+		panic(err)
+	}
+
+	return s
+}
+
 // SetUInt64 sets s to i modulo the field order, and returns an error if one occurs.
 func (s *Scalar) SetUInt64(i uint64) internal.Scalar {
 	s.scalar.SetUInt64(i)
@@ -174,11 +241,23 @@ func (s *Scalar) Copy() internal.Scalar {
 	return &Scalar{scalar: s.scalar.Copy()}
 }
 
-// Encode returns the compressed byte encoding of the scalar.
+// Encode returns the compressed byte encoding of the scalar. The library fills a new slice on each call.
 func (s *Scalar) Encode() []byte {
 	return s.scalar.Encode()
 }
 
+// SetWideBytes sets the receiver to the reduction modulo the group order of the 64-byte big-endian input, and returns
+// an error on failure.
+func (s *Scalar) SetWideBytes(in []byte) error {
+	if len(in) != 2*scalarLength {
+		return internal.ErrParamScalarLength
+	}
+
+	r := new(big.Int).SetBytes(in)
+
+	return s.Decode(r.Mod(r, fn).FillBytes(make([]byte, scalarLength)))
+}
+
 // Decode sets the receiver to a decoding of the input data, and returns an error on failure.
 func (s *Scalar) Decode(in []byte) error {
 	if err := s.scalar.Decode(in); err != nil {
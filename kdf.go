@@ -0,0 +1,65 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (C) 2020-2023 Daniel Bourdrez. All Rights Reserved.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree or at
+// https://spdx.org/licenses/MIT.html
+
+package crypto
+
+import (
+	"crypto"
+	"encoding/binary"
+	"slices"
+
+	"github.com/bytemare/hash"
+)
+
+const (
+	hkdfMaxBlocks   = 255
+	deriveScalarApp = "DeriveScalar"
+)
+
+// DeriveKey derives a symmetric key of length bytes from an ECDH shared element, using HKDF with the hash function h
+// over the element's canonical encoding, without salt, and with the info parameter. It panics if h is not an
+// available fixed-output hash function, or if length is not in [1, 255 * h.Size()].
+func (g Group) DeriveKey(shared *Element, info []byte, length int, h crypto.Hash) []byte {
+	id := hash.FromCrypto(h)
+	if id == 0 || !id.Available() || id.GetHashFunction() == nil {
+		panic(errHKDFHash)
+	}
+
+	if length <= 0 || length > hkdfMaxBlocks*h.Size() {
+		panic(errHKDFLength)
+	}
+
+	return id.GetHashFunction().HKDF(shared.Encode(), nil, info, length)
+}
+
+// ScalarFromSeed deterministically derives a uniformly distributed non-zero scalar from the seed, suitable as a private
+// key, by expanding the seed with the group's hash-to-field expander and the DST. Contrary to Random, the same seed and
+// DST always yield the same scalar, and the seed must therefore be secret and have enough entropy. In the negligible
+// case the expansion yields zero, a counter byte is appended to the seed and the expansion is repeated. The DST must
+// not be empty or nil, and is recommended to be longer than 16 bytes.
+func (g Group) ScalarFromSeed(seed, dst []byte) *Scalar {
+	s := g.HashToScalar(seed, dst)
+	for counter := byte(1); s.IsZero(); counter++ {
+		s = g.HashToScalar(slices.Concat(seed, []byte{counter}), dst)
+	}
+
+	return s
+}
+
+// DeriveScalar deterministically derives a uniformly distributed non-zero scalar from the seed and the context, e.g.
+// for reproducible test vectors or deterministic nonces. Contrary to HashToScalar, the DST is fixed internally and the
+// output is never zero. The seed is expanded with the group's hash-to-field expander over
+// I2OSP(len(context), 8) || context || seed, so that distinct (seed, context) pairs yield independent scalars.
+func (g Group) DeriveScalar(seed, context []byte) *Scalar {
+	input := make([]byte, 0, 8+len(context)+len(seed))
+	input = binary.BigEndian.AppendUint64(input, uint64(len(context)))
+	input = append(input, context...)
+	input = append(input, seed...)
+
+	return g.ScalarFromSeed(input, g.MakeDST(deriveScalarApp, 1))
+}
@@ -0,0 +1,138 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (C) 2020-2023 Daniel Bourdrez. All Rights Reserved.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree or at
+// https://spdx.org/licenses/MIT.html
+
+package crypto
+
+import (
+	"crypto/ecdh"
+	"crypto/ecdsa"
+	"crypto/x509"
+	"errors"
+	"fmt"
+)
+
+var (
+	errKeypairMismatch = errors.New("public key does not match the secret key")
+	errPKCS8KeyType    = errors.New("not an EC private key")
+	errPKCS8Curve      = errors.New("private key is on another curve")
+	errKeypairLength   = errors.New("invalid key pair encoding length")
+)
+
+// Keypair holds a secret scalar and its public element, i.e. the base point multiplied by the secret.
+type Keypair struct {
+	Secret *Scalar
+	Public *Element
+}
+
+// Encode returns the concatenation of the encodings of the secret and of the public element, as expected by
+// Group.DecodeKeypair. Both must be set.
+func (kp *Keypair) Encode() []byte {
+	return append(kp.Secret.Encode(), kp.Public.Encode()...)
+}
+
+// DecodeKeypair decodes the output of Keypair.Encode. An error is returned if the encoding has the wrong length, if the
+// secret or the public element fails to decode, or if the public element doesn't match the secret, which detects
+// corrupted or mismatched key material at parse time.
+func (g Group) DecodeKeypair(data []byte) (*Keypair, error) {
+	if len(data) != g.ScalarLength()+g.ElementLength() {
+		return nil, fmt.Errorf("decode keypair: %w", errKeypairLength)
+	}
+
+	secret := g.NewScalar()
+	if err := secret.Decode(data[:g.ScalarLength()]); err != nil {
+		return nil, fmt.Errorf("decode keypair: %w", err)
+	}
+
+	public := g.NewElement()
+	if err := public.Decode(data[g.ScalarLength():]); err != nil {
+		return nil, fmt.Errorf("decode keypair: %w", err)
+	}
+
+	if g.Base().Multiply(secret).Equal(public) != 1 {
+		return nil, fmt.Errorf("decode keypair: %w", errKeypairMismatch)
+	}
+
+	return &Keypair{Secret: secret, Public: public}, nil
+}
+
+// ecdhCurve returns the crypto/ecdh curve of the NIST groups, and errUnsupported for other groups.
+func (g Group) ecdhCurve() (ecdh.Curve, error) {
+	switch g {
+	case P256Sha256:
+		return ecdh.P256(), nil
+	case P384Sha384:
+		return ecdh.P384(), nil
+	case P521Sha512:
+		return ecdh.P521(), nil
+	default:
+		return nil, errUnsupported
+	}
+}
+
+// MarshalPKCS8 returns the DER encoding of the key pair as a PKCS #8 PrivateKeyInfo, using the id-ecPublicKey algorithm
+// identifier and the named curve of the group, as produced by x509.MarshalPKCS8PrivateKey. The result is not encrypted.
+// An error is returned if the secret is invalid, or if the public key doesn't match the secret. Only the P256, P384,
+// and P521 groups are supported, and an error is returned for other groups.
+func (kp *Keypair) MarshalPKCS8(g Group) ([]byte, error) {
+	curve, err := g.ecdhCurve()
+	if err != nil {
+		return nil, fmt.Errorf("marshal PKCS8: %w", err)
+	}
+
+	if kp.Secret == nil || kp.Public == nil || g.Base().Multiply(kp.Secret).Equal(kp.Public) != 1 {
+		return nil, fmt.Errorf("marshal PKCS8: %w", errKeypairMismatch)
+	}
+
+	key, err := curve.NewPrivateKey(kp.Secret.Encode())
+	if err != nil {
+		return nil, fmt.Errorf("marshal PKCS8: %w", err)
+	}
+
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		return nil, fmt.Errorf("marshal PKCS8: %w", err)
+	}
+
+	return der, nil
+}
+
+// ParsePKCS8 parses an unencrypted PKCS #8 PrivateKeyInfo holding an EC private key on the group's curve, and returns
+// the key pair, with the public key derived from the secret. Only the P256, P384, and P521 groups are supported, and
+// an error is returned for other groups.
+func (g Group) ParsePKCS8(der []byte) (*Keypair, error) {
+	curve, err := g.ecdhCurve()
+	if err != nil {
+		return nil, fmt.Errorf("parse PKCS8: %w", err)
+	}
+
+	key, err := x509.ParsePKCS8PrivateKey(der)
+	if err != nil {
+		return nil, fmt.Errorf("parse PKCS8: %w", err)
+	}
+
+	ecKey, ok := key.(*ecdsa.PrivateKey)
+	if !ok {
+		return nil, fmt.Errorf("parse PKCS8: %w", errPKCS8KeyType)
+	}
+
+	ecdhKey, err := ecKey.ECDH()
+	if err != nil {
+		return nil, fmt.Errorf("parse PKCS8: %w", err)
+	}
+
+	if ecdhKey.Curve() != curve {
+		return nil, fmt.Errorf("parse PKCS8: %w", errPKCS8Curve)
+	}
+
+	secret := g.NewScalar()
+	if err = secret.Decode(ecdhKey.Bytes()); err != nil {
+		return nil, fmt.Errorf("parse PKCS8: %w", err)
+	}
+
+	return &Keypair{Secret: secret, Public: g.Base().Multiply(secret)}, nil
+}
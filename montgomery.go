@@ -0,0 +1,85 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (C) 2020-2023 Daniel Bourdrez. All Rights Reserved.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree or at
+// https://spdx.org/licenses/MIT.html
+
+package crypto
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/bytemare/crypto/internal"
+)
+
+const montgomeryWordSize = 64
+
+// montgomeryParams returns the order n of the scalar field and the Montgomery constant R = 2^(64*k), where k is the
+// number of 64-bit words needed to hold n. It returns errUnsupported if the group does not use big-endian big.Int
+// scalars.
+func (g Group) montgomeryParams() (n, r *big.Int, err error) {
+	switch g {
+	case P256Sha256, P384Sha384, P521Sha512, Secp256k1:
+	default:
+		return nil, nil, errUnsupported
+	}
+
+	n = g.OrderBigInt()
+	words := (n.BitLen() + montgomeryWordSize - 1) / montgomeryWordSize
+	r = new(big.Int).Lsh(big.NewInt(1), uint(words*montgomeryWordSize))
+
+	return n, r, nil
+}
+
+// ScalarToMontgomery returns the big-endian encoding of s in Montgomery form, i.e. s * R mod n, where n is the order
+// and R = 2^(64*k) with k the number of 64-bit words of n. Only the P256, P384, P521, and Secp256k1 groups support
+// this representation, and this function panics for other groups.
+func (g Group) ScalarToMontgomery(s *Scalar) []byte {
+	n, r, err := g.montgomeryParams()
+	if err != nil {
+		panic(err)
+	}
+
+	m := new(big.Int).SetBytes(s.Encode())
+	m.Mul(m, r)
+	m.Mod(m, n)
+
+	return m.FillBytes(make([]byte, g.ScalarLength()))
+}
+
+// ScalarFromMontgomery returns the scalar whose Montgomery form is given by the big-endian encoding in b, i.e. b * R^-1
+// mod n. Only the P256, P384, P521, and Secp256k1 groups support this representation, and an error is returned for
+// other groups.
+func (g Group) ScalarFromMontgomery(b []byte) (*Scalar, error) {
+	n, r, err := g.montgomeryParams()
+	if err != nil {
+		return nil, fmt.Errorf("montgomery: %w", err)
+	}
+
+	switch len(b) {
+	case 0:
+		return nil, internal.ErrParamNilScalar
+	case g.ScalarLength():
+		break
+	default:
+		return nil, internal.ErrParamScalarLength
+	}
+
+	m := new(big.Int).SetBytes(b)
+	if m.Cmp(n) >= 0 {
+		return nil, internal.ErrParamScalarInvalidEncoding
+	}
+
+	m.Mul(m, r.ModInverse(r, n))
+	m.Mod(m, n)
+
+	s := g.NewScalar()
+	if err = s.Decode(m.FillBytes(make([]byte, g.ScalarLength()))); err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
@@ -0,0 +1,105 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (C) 2020-2023 Daniel Bourdrez. All Rights Reserved.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree or at
+// https://spdx.org/licenses/MIT.html
+
+package crypto
+
+// BaseMultiplesEncoded returns the encodings of the n first multiples of the base point, i.e. 0*G, 1*G, ..., (n-1)*G,
+// computed by successive additions. The first entry is the encoding of the identity element, which Decode rejects.
+// It returns an empty slice if n is not positive.
+func (g Group) BaseMultiplesEncoded(n int) [][]byte {
+	if n <= 0 {
+		return [][]byte{}
+	}
+
+	table := make([][]byte, n)
+	base := g.Base()
+	acc := g.NewElement()
+
+	for i := range table {
+		table[i] = acc.Encode()
+		acc.Add(base)
+	}
+
+	return table
+}
+
+// PowersOf returns the n first powers of x, i.e. 1, x, x^2, ..., x^(n-1), computed by successive multiplications. A
+// nil x is treated as 0. It returns an empty slice if n is not positive.
+func (g Group) PowersOf(x *Scalar, n int) []*Scalar {
+	if n <= 0 {
+		return []*Scalar{}
+	}
+
+	powers := make([]*Scalar, n)
+	powers[0] = g.NewScalar().One()
+
+	for i := 1; i < n; i++ {
+		powers[i] = powers[i-1].Copy().Multiply(x)
+	}
+
+	return powers
+}
+
+// Table holds the multiples 0*B, 1*B, ..., (2^w-1)*B of an element B, for fixed-window multiplication with window w.
+type Table struct {
+	multiples []*Element
+	window    int
+}
+
+// PrecomputeTable returns the table of the 2^window first multiples of base, to be used in Table.Multiply. A nil base
+// is treated as the identity element. It panics if window is not between 1 and 8.
+func (g Group) PrecomputeTable(base *Element, window int) *Table {
+	if window < 1 || window > 8 {
+		panic(errWindow)
+	}
+
+	if base == nil {
+		base = g.NewElement()
+	}
+
+	multiples := make([]*Element, 1<<window)
+	multiples[0] = g.NewElement()
+
+	for i := 1; i < len(multiples); i++ {
+		multiples[i] = multiples[i-1].Copy().Add(base)
+	}
+
+	return &Table{multiples: multiples, window: window}
+}
+
+// Multiply returns a new element set to the table's base multiplied by the scalar. The scalar is processed in windows
+// of fixed length from the most significant bit, and each multiple is picked with SelectFrom, so that the sequence of
+// operations doesn't depend on the value of the scalar. A nil scalar is treated as 0.
+func (t *Table) Multiply(scalar *Scalar) *Element {
+	acc := t.multiples[0].Copy()
+	if scalar == nil {
+		return acc
+	}
+
+	enc := scalar.bigEndian()
+	bits := 8 * len(enc)
+	selected := acc.Copy()
+
+	for pos := (bits+t.window-1)/t.window*t.window - t.window; pos >= 0; pos -= t.window {
+		acc.DoubleN(t.window)
+
+		digit := 0
+
+		for i := t.window - 1; i >= 0; i-- {
+			digit <<= 1
+
+			if j := pos + i; j < bits {
+				digit |= int(enc[len(enc)-1-j/8]>>(j%8)) & 1
+			}
+		}
+
+		acc.Add(selected.SelectFrom(t.multiples, digit))
+	}
+
+	return acc
+}
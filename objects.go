@@ -0,0 +1,83 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (C) 2020-2023 Daniel Bourdrez. All Rights Reserved.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree or at
+// https://spdx.org/licenses/MIT.html
+
+package crypto
+
+import (
+	"encoding/binary"
+	"fmt"
+)
+
+// objectsHeaderLength is the length of the group identifier and the two 4-byte counts.
+const objectsHeaderLength = 1 + 4 + 4
+
+// MarshalObjects encodes the scalars and elements into a self-describing container: a header with the group
+// identifier, the number of scalars, and the number of elements, each count in 4-byte big-endian, followed by the
+// canonical encodings of the scalars and then of the elements. Elements must not be the identity element, which is
+// rejected by UnmarshalObjects.
+func (g Group) MarshalObjects(scalars []*Scalar, elements []*Element) []byte {
+	out := make([]byte, objectsHeaderLength, objectsHeaderLength+
+		len(scalars)*g.ScalarLength()+len(elements)*g.ElementLength())
+	out[0] = byte(g)
+	binary.BigEndian.PutUint32(out[1:5], uint32(len(scalars)))
+	binary.BigEndian.PutUint32(out[5:9], uint32(len(elements)))
+
+	for _, s := range scalars {
+		out = append(out, s.Encode()...)
+	}
+
+	for _, e := range elements {
+		out = append(out, e.Encode()...)
+	}
+
+	return out
+}
+
+// UnmarshalObjects decodes the output of MarshalObjects, and returns an error if the objects were encoded for another
+// group, if the length doesn't match the counts in the header, or if any scalar or element fails to decode.
+func (g Group) UnmarshalObjects(data []byte) ([]*Scalar, []*Element, error) {
+	if len(data) < objectsHeaderLength {
+		return nil, nil, fmt.Errorf("unmarshal objects: %w", errObjLength)
+	}
+
+	if Group(data[0]) != g {
+		return nil, nil, fmt.Errorf("unmarshal objects: %w", errObjGroup)
+	}
+
+	sLen, eLen := g.ScalarLength(), g.ElementLength()
+	nScalars := uint64(binary.BigEndian.Uint32(data[1:5]))
+	nElements := uint64(binary.BigEndian.Uint32(data[5:9]))
+
+	if uint64(len(data)-objectsHeaderLength) != nScalars*uint64(sLen)+nElements*uint64(eLen) {
+		return nil, nil, fmt.Errorf("unmarshal objects: %w", errObjLength)
+	}
+
+	data = data[objectsHeaderLength:]
+	scalars := make([]*Scalar, nScalars)
+	elements := make([]*Element, nElements)
+
+	for i := range scalars {
+		scalars[i] = g.NewScalar()
+		if err := scalars[i].Decode(data[:sLen]); err != nil {
+			return nil, nil, fmt.Errorf("unmarshal objects: scalar %d: %w", i, err)
+		}
+
+		data = data[sLen:]
+	}
+
+	for i := range elements {
+		elements[i] = g.NewElement()
+		if err := elements[i].Decode(data[:eLen]); err != nil {
+			return nil, nil, fmt.Errorf("unmarshal objects: element %d: %w", i, err)
+		}
+
+		data = data[eLen:]
+	}
+
+	return scalars, elements, nil
+}
@@ -0,0 +1,60 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (C) 2020-2023 Daniel Bourdrez. All Rights Reserved.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree or at
+// https://spdx.org/licenses/MIT.html
+
+package crypto
+
+import "crypto"
+
+// GroupParameters holds the full parameter set of a Group, e.g. for documentation or audit purposes.
+type GroupParameters struct {
+	// FieldOrder is the decimal representation of the order of the base field the curve is defined over.
+	FieldOrder string
+
+	// Order is the decimal representation of the order of the prime-order group.
+	Order string
+
+	// HashToGroup is the hash-to-curve ciphersuite identifier used by HashToGroup (random oracle).
+	HashToGroup string
+
+	// EncodeToGroup is the hash-to-curve ciphersuite identifier used by EncodeToGroup (non-uniform).
+	EncodeToGroup string
+
+	// Generator is the canonical encoding of the group's base point.
+	Generator []byte
+
+	// Cofactor is the cofactor of the group's elements, which is 1 for prime-order groups and abstractions like
+	// Ristretto255.
+	Cofactor uint
+
+	// SecurityLevel is the target security level k, in bits, of the hash-to-curve suite.
+	SecurityLevel uint
+
+	// Hash is the hash function used by the expander.
+	Hash crypto.Hash
+}
+
+// Parameters returns the full parameter set of the group.
+func (g Group) Parameters() GroupParameters {
+	p := g.get()
+	params := GroupParameters{
+		FieldOrder:    p.FieldOrder(),
+		Order:         p.Order(),
+		HashToGroup:   p.Ciphersuite(),
+		EncodeToGroup: p.CiphersuiteNU(),
+		Generator:     g.Base().Encode(),
+		Cofactor:      1,
+		SecurityLevel: p.SecurityLevel(),
+		Hash:          p.HashFunc(),
+	}
+
+	if g == Edwards25519Sha512 {
+		params.Cofactor = 8
+	}
+
+	return params
+}
@@ -0,0 +1,57 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (C) 2020-2023 Daniel Bourdrez. All Rights Reserved.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree or at
+// https://spdx.org/licenses/MIT.html
+
+package crypto
+
+import "time"
+
+// ProfileIterations is the number of times each operation is run by BenchmarkProfile.
+const ProfileIterations = 20
+
+// ProfileResult holds the estimated time per operation of a group's core operations.
+type ProfileResult struct {
+	// ScalarBaseMult is the time to multiply the base point with a scalar.
+	ScalarBaseMult time.Duration
+
+	// ScalarMult is the time to multiply an arbitrary element with a scalar.
+	ScalarMult time.Duration
+
+	// HashToGroup is the time to hash a 32-byte input to the group.
+	HashToGroup time.Duration
+
+	// Add is the time to add two elements.
+	Add time.Duration
+}
+
+// profile returns the average time per call of f over ProfileIterations runs.
+func profile(f func()) time.Duration {
+	start := time.Now()
+	for i := 0; i < ProfileIterations; i++ {
+		f()
+	}
+
+	return time.Since(start) / ProfileIterations
+}
+
+// BenchmarkProfile times the group's core operations over ProfileIterations runs each, and returns the estimated time
+// per operation. This helps choosing a group for a given performance budget, but is not a substitute for proper
+// benchmarks using the testing package.
+func (g Group) BenchmarkProfile() ProfileResult {
+	s := g.NewScalar().Random()
+	p := g.Base().Multiply(g.NewScalar().Random())
+	q := g.Base().Multiply(g.NewScalar().Random())
+	input := make([]byte, 32)
+	dst := g.MakeDST("BenchmarkProfile", 1)
+
+	return ProfileResult{
+		ScalarBaseMult: profile(func() { g.Base().Multiply(s) }),
+		ScalarMult:     profile(func() { p.Copy().Multiply(s) }),
+		HashToGroup:    profile(func() { g.HashToGroup(input, dst) }),
+		Add:            profile(func() { p.Copy().Add(q) }),
+	}
+}
@@ -0,0 +1,35 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (C) 2020-2023 Daniel Bourdrez. All Rights Reserved.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree or at
+// https://spdx.org/licenses/MIT.html
+
+package crypto
+
+import (
+	"fmt"
+
+	"github.com/bytemare/crypto/internal"
+	"github.com/bytemare/crypto/internal/secp256k1"
+)
+
+// RecoverPublicKey returns the public key recovered from the ECDSA signature (r, s) over msgHash, as specified in
+// SEC 1 v2 section 4.1.6. recoveryID must be between 0 and 3. This is only supported by the Secp256k1 group.
+func (g Group) RecoverPublicKey(r, s *Scalar, recoveryID int, msgHash []byte) (*Element, error) {
+	if g != Secp256k1 {
+		return nil, errUnsupported
+	}
+
+	if r == nil || s == nil {
+		return nil, internal.ErrParamNilScalar
+	}
+
+	q, err := secp256k1.RecoverPublicKey(r.Scalar, s.Scalar, recoveryID, msgHash)
+	if err != nil {
+		return nil, fmt.Errorf("public key recovery: %w", err)
+	}
+
+	return newPoint(q), nil
+}
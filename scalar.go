@@ -11,6 +11,8 @@ package crypto
 
 import (
 	"fmt"
+	"math/big"
+	"slices"
 	"strings"
 
 	"github.com/bytemare/crypto/internal"
@@ -56,6 +58,12 @@ func (s *Scalar) Add(scalar *Scalar) *Scalar {
 	return s
 }
 
+// Double sets the receiver to its double, and returns the receiver.
+func (s *Scalar) Double() *Scalar {
+	s.Scalar.Double()
+	return s
+}
+
 // Subtract subtracts the input from the receiver, and returns the receiver.
 func (s *Scalar) Subtract(scalar *Scalar) *Scalar {
 	if scalar == nil {
@@ -67,6 +75,12 @@ func (s *Scalar) Subtract(scalar *Scalar) *Scalar {
 	return s
 }
 
+// Negate sets the receiver to its additive inverse, and returns the receiver.
+func (s *Scalar) Negate() *Scalar {
+	s.Scalar.Negate()
+	return s
+}
+
 // Multiply multiplies the receiver with the input, and returns the receiver.
 func (s *Scalar) Multiply(scalar *Scalar) *Scalar {
 	if scalar == nil {
@@ -78,7 +92,25 @@ func (s *Scalar) Multiply(scalar *Scalar) *Scalar {
 	return s
 }
 
-// Pow sets s to s**scalar modulo the group order, and returns s. If scalar is nil, it returns 1.
+// MulAdd sets the receiver to receiver * b + c modulo the group order, and returns the receiver, with a single
+// reduction where the backend allows it. It is equivalent to Multiply(b) followed by Add(c), but the arguments can
+// alias the receiver. A nil b or c is treated as 0.
+func (s *Scalar) MulAdd(b, c *Scalar) *Scalar {
+	switch {
+	case b == nil:
+		return s.Set(c)
+	case c == nil:
+		return s.Multiply(b)
+	}
+
+	s.Scalar.MulAdd(b.Scalar, c.Scalar)
+
+	return s
+}
+
+// Pow sets s to s**scalar modulo the group order, and returns s. If scalar is nil, it returns 1. It is not
+// constant-time with regard to the exponent for any group: the NIST groups use big.Int exponentiation, and the other
+// groups skip the leading zero bits of the exponent. Use PowCT for secret exponents.
 func (s *Scalar) Pow(scalar *Scalar) *Scalar {
 	if scalar == nil {
 		return s.One()
@@ -89,7 +121,37 @@ func (s *Scalar) Pow(scalar *Scalar) *Scalar {
 	return s
 }
 
-// Invert sets the receiver to the scalar's modular inverse ( 1 / scalar ), and returns it.
+// PowCT sets s to s**scalar modulo the group order, and returns s. If scalar is nil, it returns 1. Contrary to Pow, it
+// uses a square-and-multiply with a fixed number of iterations over all the bits of the scalar encoding, where every
+// multiplication is performed and its result selected with CondSet, so that the sequence of operations doesn't depend
+// on the exponent. This comes with the caveat that the scalar arithmetic of the NIST and Secp256k1 groups is big.Int
+// based and not constant-time itself.
+func (s *Scalar) PowCT(scalar *Scalar) *Scalar {
+	if scalar == nil {
+		return s.One()
+	}
+
+	bits := scalar.bigEndian()
+	base := s.Copy()
+	square := s.Copy()
+	product := s.Copy()
+
+	s.One()
+
+	for _, b := range bits {
+		for j := 7; j >= 0; j-- {
+			s.Multiply(square.Set(s))
+			s.CondSet(product.Set(s).Multiply(base), int(b>>j)&1)
+		}
+	}
+
+	return s
+}
+
+// Invert sets the receiver to the scalar's modular inverse ( 1 / scalar ), and returns it. Since 0 has no inverse, it
+// is left unchanged, for all groups, so use IsInvertible to reject it beforehand if it must not happen. The inversion
+// is constant-time for Ristretto255 and Edwards25519 only: the NIST groups and Secp256k1 use math/big exponentiation,
+// which is not.
 func (s *Scalar) Invert() *Scalar {
 	s.Scalar.Invert()
 	return s
@@ -113,11 +175,67 @@ func (s *Scalar) LessOrEqual(scalar *Scalar) int {
 	return s.Scalar.LessOrEqual(scalar.Scalar)
 }
 
+// Cmp compares the numeric values of s and scalar, and returns -1 if s < scalar, 0 if s == scalar, and 1 if s > scalar,
+// regardless of the endianness of the group's scalar encoding, e.g. to sort scalars. A nil scalar is treated as 0. It
+// is not constant-time, and must only be used on public values: use CmpCT for secret ones.
+func (s *Scalar) Cmp(scalar *Scalar) int {
+	if scalar == nil {
+		if s.IsZero() {
+			return 0
+		}
+
+		return 1
+	}
+
+	return s.Scalar.Cmp(scalar.Scalar)
+}
+
+// CmpCT compares the values of s and scalar, and returns -1 if s < scalar, 0 if s == scalar, and 1 if s > scalar. The
+// comparison runs over the whole big-endian encodings without data-dependent branches or memory accesses, so that only
+// the result itself is revealed, and not at which byte the scalars differ. A nil scalar is treated as 0. Like Cmp, it
+// panics if the scalars are of different groups.
+func (s *Scalar) CmpCT(scalar *Scalar) int {
+	a := s.bigEndian()
+
+	var b []byte
+	if scalar == nil {
+		b = make([]byte, len(a))
+	} else {
+		if s.Scalar.Ciphersuite() != scalar.Scalar.Ciphersuite() {
+			panic(internal.ErrCastScalar)
+		}
+
+		b = scalar.bigEndian()
+	}
+
+	// gt and lt are set at the first differing byte, starting from the most significant one, and frozen afterwards.
+	var gt, lt int
+	for i := range a {
+		x, y := int(a[i]), int(b[i])
+		undecided := 1 ^ (gt | lt)
+		gt |= ((y - x) >> 8) & 1 & undecided
+		lt |= ((x - y) >> 8) & 1 & undecided
+	}
+
+	return gt - lt
+}
+
 // IsZero returns whether the scalar is 0.
 func (s *Scalar) IsZero() bool {
 	return s.Scalar.IsZero()
 }
 
+// IsOne returns whether the scalar is 1.
+func (s *Scalar) IsOne() bool {
+	return s.Scalar.IsOne()
+}
+
+// IsInvertible returns whether the scalar has a multiplicative inverse modulo the group order. Since all groups have
+// prime order, this is true if and only if the scalar is not 0.
+func (s *Scalar) IsInvertible() bool {
+	return !s.IsZero()
+}
+
 // Set sets the receiver to the value of the argument scalar, and returns the receiver.
 func (s *Scalar) Set(scalar *Scalar) *Scalar {
 	if scalar == nil {
@@ -129,12 +247,50 @@ func (s *Scalar) Set(scalar *Scalar) *Scalar {
 	return s
 }
 
+// CondSet sets the receiver to the argument if cond is 1, leaves it unchanged if cond is 0, and returns the receiver.
+// The selection is constant-time, which allows choosing between secret values based on a secret bit, with the caveat
+// that the scalar arithmetic of the NIST and Secp256k1 groups is not constant-time itself. A nil scalar is treated as
+// 0. The behavior is undefined if cond is not 0 or 1.
+func (s *Scalar) CondSet(scalar *Scalar, cond int) *Scalar {
+	if scalar == nil {
+		scalar = s.Copy().Zero()
+	}
+
+	s.Scalar.CondSet(scalar.Scalar, cond)
+
+	return s
+}
+
 // SetUInt64 sets s to i modulo the field order, and returns an error if one occurs.
 func (s *Scalar) SetUInt64(i uint64) *Scalar {
 	s.Scalar.SetUInt64(i)
 	return s
 }
 
+// SetBigInt sets s to i modulo the group order, and returns it. Negative values are reduced to their non-negative
+// representative, e.g. -1 yields the order minus one. A nil i is treated as 0. This is not constant-time.
+func (s *Scalar) SetBigInt(i *big.Int) *Scalar {
+	if i == nil {
+		return s.Zero()
+	}
+
+	// The order is the largest scalar plus one.
+	maxScalar := s.Copy().One().Negate().bigEndian()
+	order := new(big.Int).SetBytes(maxScalar)
+	order.Add(order, big.NewInt(1))
+
+	b := new(big.Int).Mod(i, order).FillBytes(maxScalar)
+	if s.isLittleEndian() {
+		slices.Reverse(b)
+	}
+
+	if err := s.Scalar.Decode(b); err != nil {
+		panic(err)
+	}
+
+	return s
+}
+
 // UInt64 returns the uint64 representation of the scalar,
 // or an error if its value is higher than the authorized limit for uint64.
 func (s *Scalar) UInt64() (uint64, error) {
@@ -151,7 +307,8 @@ func (s *Scalar) Copy() *Scalar {
 	return &Scalar{Scalar: s.Scalar.Copy()}
 }
 
-// Encode returns the compressed byte encoding of the scalar.
+// Encode returns the compressed byte encoding of the scalar. The returned slice is allocated on every call, and can be
+// modified by the caller without affecting the scalar.
 func (s *Scalar) Encode() []byte {
 	return s.Scalar.Encode()
 }
@@ -165,11 +322,95 @@ func (s *Scalar) Decode(data []byte) error {
 	return nil
 }
 
+// clampedScalar is implemented by the scalars of the groups that support X25519-style clamped scalars.
+type clampedScalar interface {
+	DecodeClamped(in []byte) error
+}
+
+// DecodeClamped sets the receiver to the 32-byte little-endian X25519 or Ed25519 secret after applying the clamping of
+// RFC 7748 and RFC 8032, i.e. clearing the 3 lowest and the highest bits and setting the second highest, and reduced
+// modulo the order. The input is not modified. Multiplying the base point with the result yields the same public key
+// as X25519 and Ed25519 do, up to the Montgomery and Edwards forms. Only Edwards25519 supports this, and an error is
+// returned for the other groups.
+func (s *Scalar) DecodeClamped(data []byte) error {
+	c, ok := s.Scalar.(clampedScalar)
+	if !ok {
+		return fmt.Errorf("scalar DecodeClamped: %w", errUnsupported)
+	}
+
+	if err := c.DecodeClamped(data); err != nil {
+		return fmt.Errorf("scalar DecodeClamped: %w", err)
+	}
+
+	return nil
+}
+
+// SetWideBytes sets the receiver to the reduction modulo the group order of the input, which must be twice the scalar
+// length, and returns an error on failure. This is meant for uniform bytes, e.g. from a KDF, for which the bias of the
+// reduction is negligible. Like the scalar encoding, the input is little-endian for Ristretto255 and Edwards25519,
+// using 64 bytes, and big-endian for the other groups, i.e. 64 bytes for P256 and Secp256k1, 96 bytes for P384, and
+// 132 bytes for P521.
+func (s *Scalar) SetWideBytes(data []byte) error {
+	if err := s.Scalar.SetWideBytes(data); err != nil {
+		return fmt.Errorf("scalar SetWideBytes: %w", err)
+	}
+
+	return nil
+}
+
 // Hex returns the fixed-sized hexadecimal encoding of s.
 func (s *Scalar) Hex() string {
 	return s.Scalar.Hex()
 }
 
+// bigEndian returns the encoding of s in big-endian, independently of the group's scalar encoding.
+func (s *Scalar) bigEndian() []byte {
+	b := s.Scalar.Encode()
+	if s.isLittleEndian() {
+		slices.Reverse(b)
+	}
+
+	return b
+}
+
+// isLittleEndian returns whether the group's scalars are encoded in little-endian.
+func (s *Scalar) isLittleEndian() bool {
+	return s.Scalar.IsLittleEndian()
+}
+
+// DebugString returns the decimal representation of the value of s, e.g. for debugging and test failure messages.
+// Contrary to Hex, it does not depend on the endianness of the group's scalar encoding.
+func (s *Scalar) DebugString() string {
+	return new(big.Int).SetBytes(s.bigEndian()).String()
+}
+
+// SplitHalves returns the scalars hi and lo such that s = lo + hi * 2^k, where k is half the bit length of the scalar
+// encoding, e.g. 128 for 32-byte scalars, and hi and lo are lower than 2^k. This is not constant-time.
+func (s *Scalar) SplitHalves() (hi, lo *Scalar) {
+	b := s.bigEndian()
+	half := len(b) / 2
+
+	return s.fromBigEndian(b[:half]), s.fromBigEndian(b[half:])
+}
+
+// fromBigEndian returns a new scalar of the same group as s, set to the big-endian value in b, which must be lower
+// than the order.
+func (s *Scalar) fromBigEndian(b []byte) *Scalar {
+	enc := make([]byte, len(s.Scalar.Encode()))
+	copy(enc[len(enc)-len(b):], b)
+
+	if s.isLittleEndian() {
+		slices.Reverse(enc)
+	}
+
+	r := s.Copy()
+	if err := r.Decode(enc); err != nil {
+		panic(err)
+	}
+
+	return r
+}
+
 // DecodeHex sets s to the decoding of the hex encoded scalar.
 func (s *Scalar) DecodeHex(h string) error {
 	if err := s.Scalar.DecodeHex(h); err != nil {
@@ -190,6 +431,17 @@ func (s *Scalar) UnmarshalJSON(data []byte) error {
 	return s.DecodeHex(j)
 }
 
+// MarshalText implements the encoding.TextMarshaler interface, and returns the hexadecimal encoding of the scalar.
+func (s *Scalar) MarshalText() ([]byte, error) {
+	return []byte(s.Hex()), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface, and sets s to the decoding of the hex encoded
+// scalar.
+func (s *Scalar) UnmarshalText(data []byte) error {
+	return s.DecodeHex(string(data))
+}
+
 // MarshalBinary implements the encoding.BinaryMarshaler interface.
 func (s *Scalar) MarshalBinary() ([]byte, error) {
 	return s.Scalar.Encode(), nil
@@ -0,0 +1,179 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (C) 2020-2023 Daniel Bourdrez. All Rights Reserved.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree or at
+// https://spdx.org/licenses/MIT.html
+
+package group_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/bytemare/crypto"
+	"github.com/bytemare/crypto/internal"
+)
+
+func TestAccumulateElements(t *testing.T) {
+	dst := []byte("AccumulateElements-test-DST")
+
+	testAllGroups(t, func(group *testGroup) {
+		g := group.group
+		elements := randomElements(g, 4)
+		acc := g.AccumulateElements(elements, dst)
+
+		// Deterministic
+		if acc.Equal(g.AccumulateElements(elements, dst)) != 1 {
+			t.Fatal(errExpectedEquality)
+		}
+
+		// The documented construction
+		expected := g.NewElement()
+		for _, e := range elements {
+			expected.Add(g.Base().Multiply(g.ChallengeFromElements(dst, expected, e)))
+		}
+
+		if acc.Equal(expected) != 1 {
+			t.Fatal(errExpectedEquality)
+		}
+
+		// Order-sensitive
+		swapped := []*crypto.Element{elements[1], elements[0], elements[2], elements[3]}
+		if acc.Equal(g.AccumulateElements(swapped, dst)) == 1 {
+			t.Fatal(errUnExpectedEquality)
+		}
+
+		// Another element, fewer elements, or another DST yield another accumulator.
+		other := []*crypto.Element{elements[0], elements[1], elements[2], g.Base()}
+		if acc.Equal(g.AccumulateElements(other, dst)) == 1 {
+			t.Fatal(errUnExpectedEquality)
+		}
+
+		if acc.Equal(g.AccumulateElements(elements[:3], dst)) == 1 {
+			t.Fatal(errUnExpectedEquality)
+		}
+
+		if acc.Equal(g.AccumulateElements(elements, []byte("another-test-DST"))) == 1 {
+			t.Fatal(errUnExpectedEquality)
+		}
+
+		// Empty list
+		if !g.AccumulateElements(nil, dst).IsIdentity() {
+			t.Fatal("expected identity")
+		}
+
+		if err := testPanic("zero length DST", errors.New("zero-length DST"), func() {
+			_ = g.AccumulateElements(elements, nil)
+		}); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+func TestScalarAccumulator(t *testing.T) {
+	testAllGroups(t, func(group *testGroup) {
+		g := group.group
+		scalars := randomScalars(g, 20)
+		acc := g.NewScalarAccumulator()
+
+		if !acc.Sum().IsZero() {
+			t.Fatal("expected zero sum")
+		}
+
+		expected := g.NewScalar()
+		for _, s := range scalars {
+			acc.Add(s)
+			expected = expected.Copy().Add(s)
+
+			if acc.Sum().Equal(expected) != 1 {
+				t.Fatal(errExpectedEquality)
+			}
+		}
+
+		// The returned sum is a copy, and nil scalars are ignored.
+		sum := acc.Sum()
+		acc.Add(g.NewScalar().One())
+		acc.Add(nil)
+
+		if sum.Equal(expected) != 1 {
+			t.Fatal(errExpectedEquality)
+		}
+
+		if acc.Sum().Equal(expected.Add(g.NewScalar().One())) != 1 {
+			t.Fatal(errExpectedEquality)
+		}
+	})
+}
+
+func TestSumScalars(t *testing.T) {
+	testAllGroups(t, func(group *testGroup) {
+		g := group.group
+		scalars := randomScalars(g, 5)
+
+		expected := g.NewScalar()
+		for _, s := range scalars {
+			expected.Add(s)
+		}
+
+		if g.SumScalars(scalars...).Equal(expected) != 1 {
+			t.Fatal(errExpectedEquality)
+		}
+
+		// Nil scalars are ignored, and the inputs are not modified.
+		reference := scalars[0].Copy()
+		if g.SumScalars(append(scalars, nil)...).Equal(expected) != 1 || scalars[0].Equal(reference) != 1 {
+			t.Fatal(errExpectedEquality)
+		}
+
+		if !g.SumScalars().IsZero() {
+			t.Fatal("expected zero")
+		}
+
+		if err := testPanic(errWrongGroup, internal.ErrCastScalar, func() {
+			_ = g.SumScalars(scalars[0], otherGroup(g).NewScalar().One())
+		}); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+func TestProductScalars(t *testing.T) {
+	testAllGroups(t, func(group *testGroup) {
+		g := group.group
+		scalars := randomScalars(g, 5)
+
+		expected := g.NewScalar().One()
+		for _, s := range scalars {
+			expected.Multiply(s)
+		}
+
+		if g.ProductScalars(scalars...).Equal(expected) != 1 {
+			t.Fatal(errExpectedEquality)
+		}
+
+		if !g.ProductScalars().IsOne() {
+			t.Fatal("expected one")
+		}
+
+		if !g.ProductScalars(scalars[0], nil).IsZero() {
+			t.Fatal("expected zero")
+		}
+
+		if err := testPanic(errWrongGroup, internal.ErrCastScalar, func() {
+			_ = g.ProductScalars(scalars[0], otherGroup(g).NewScalar().One())
+		}); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+// otherGroup returns a group with another scalar type than g.
+func otherGroup(g crypto.Group) crypto.Group {
+	if g == crypto.Ristretto255Sha512 {
+		return crypto.P256Sha256
+	}
+
+	return crypto.Ristretto255Sha512
+}
@@ -0,0 +1,216 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (C) 2020-2023 Daniel Bourdrez. All Rights Reserved.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree or at
+// https://spdx.org/licenses/MIT.html
+
+package group_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/bytemare/crypto"
+)
+
+func TestSumIsIdentity(t *testing.T) {
+	testAllGroups(t, func(group *testGroup) {
+		p := group.group.Base().Multiply(group.group.NewScalar().Random())
+		q := group.group.Base().Multiply(group.group.NewScalar().Random())
+
+		// P + (-P) + identity
+		if !group.group.SumIsIdentity([]*crypto.Element{p, p.Copy().Negate(), group.group.NewElement()}) {
+			t.Fatal("expected identity sum")
+		}
+
+		// P + Q - (P + Q), with a nil element
+		if !group.group.SumIsIdentity([]*crypto.Element{p, q, nil, p.Copy().Add(q).Negate()}) {
+			t.Fatal("expected identity sum")
+		}
+
+		if !group.group.SumIsIdentity(nil) {
+			t.Fatal("expected identity sum for empty list")
+		}
+
+		// Non-zero sums
+		if group.group.SumIsIdentity([]*crypto.Element{p, q.Copy().Negate(), group.group.NewElement()}) {
+			t.Fatal("unexpected identity sum")
+		}
+
+		if group.group.SumIsIdentity([]*crypto.Element{p}) {
+			t.Fatal("unexpected identity sum")
+		}
+
+		// The inputs are not modified.
+		if p.Equal(q) == 1 || p.IsIdentity() {
+			t.Fatal("unexpected modification of the input")
+		}
+	})
+}
+
+func TestElementsEqual(t *testing.T) {
+	testAllGroups(t, func(group *testGroup) {
+		g := group.group
+		a := []*crypto.Element{g.Base(), g.RandomElement(), g.NewElement(), g.RandomElement()}
+		b := make([]*crypto.Element, len(a))
+
+		for i, e := range a {
+			b[i] = e.Copy()
+		}
+
+		if g.ElementsEqual(a, b) != 1 || g.ElementsEqual(nil, []*crypto.Element{}) != 1 {
+			t.Fatal(errExpectedEquality)
+		}
+
+		// A difference at any position, a different order, or a different length.
+		for i := range b {
+			c := slices.Clone(b)
+			c[i] = c[i].Copy().Add(g.Base())
+
+			if g.ElementsEqual(a, c) != 0 {
+				t.Fatalf("%d: %s", i, errUnExpectedEquality)
+			}
+		}
+
+		reversed := slices.Clone(b)
+		slices.Reverse(reversed)
+
+		for _, c := range [][]*crypto.Element{reversed, b[:3], append(slices.Clone(b), g.Base()), nil} {
+			if g.ElementsEqual(a, c) != 0 {
+				t.Fatal(errUnExpectedEquality)
+			}
+		}
+
+		// Nil elements never compare equal.
+		withNil := []*crypto.Element{g.Base(), nil}
+		if g.ElementsEqual(withNil, withNil) != 0 {
+			t.Fatal(errUnExpectedEquality)
+		}
+	})
+}
+
+func TestNegateAll(t *testing.T) {
+	testAllGroups(t, func(group *testGroup) {
+		g := group.group
+		minusOne := g.NewScalar().Subtract(g.NewScalar().One())
+		elements := []*crypto.Element{
+			g.Base(),
+			g.NewElement(),
+			g.Base().Multiply(g.NewScalar().Random()),
+			decodeElement(t, g, g.Base().Double().Hex()),
+			nil,
+		}
+
+		originals := make([]*crypto.Element, len(elements))
+		for i, e := range elements {
+			if e != nil {
+				originals[i] = e.Copy()
+			}
+		}
+
+		g.NegateAll(elements)
+
+		for i, e := range elements {
+			if e == nil {
+				continue
+			}
+
+			// -P = (order - 1) * P, and has the same encoding.
+			expected := originals[i].Copy().Multiply(minusOne)
+			if e.Equal(expected) != 1 || e.Hex() != expected.Hex() {
+				t.Fatalf("%d: %s", i, errExpectedEquality)
+			}
+
+			if !e.Copy().Add(originals[i]).IsIdentity() {
+				t.Fatalf("%d: expected identity", i)
+			}
+		}
+
+		if !elements[1].IsIdentity() {
+			t.Fatal("expected identity")
+		}
+
+		// Negating twice restores the originals.
+		g.NegateAll(elements)
+
+		for i, e := range elements {
+			if e == nil {
+				continue
+			}
+
+			if e.Equal(originals[i]) != 1 || e.Hex() != originals[i].Hex() {
+				t.Fatalf("%d: %s", i, errExpectedEquality)
+			}
+		}
+
+		g.NegateAll(nil)
+	})
+}
+
+func TestECDHBatch(t *testing.T) {
+	testAllGroups(t, func(group *testGroup) {
+		g := group.group
+		priv := g.NewScalar().Random()
+		peers := randomElements(g, 5)
+
+		shared, err := g.ECDHBatch(priv, peers)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if len(shared) != len(peers) {
+			t.Fatalf("expected %d shared secrets, got %d", len(peers), len(shared))
+		}
+
+		for i, p := range peers {
+			if shared[i].Equal(p.Copy().Multiply(priv)) != 1 {
+				t.Fatalf("%d: %s", i, errExpectedEquality)
+			}
+
+			// The peers' public keys are not modified.
+			if shared[i] == p || p.Equal(shared[i]) == 1 {
+				t.Fatalf("%d: %s", i, errUnExpectedEquality)
+			}
+		}
+
+		if shared, err = g.ECDHBatch(priv, nil); err != nil || len(shared) != 0 {
+			t.Fatalf("unexpected result on empty input: %v", err)
+		}
+	})
+}
+
+func TestECDHBatch_Invalid(t *testing.T) {
+	testAllGroups(t, func(group *testGroup) {
+		g := group.group
+		priv := g.NewScalar().Random()
+
+		invalid := []*crypto.Element{nil, g.NewElement()}
+		if g == crypto.Edwards25519Sha512 {
+			for _, lowOrder := range edwards25519LowOrder {
+				invalid = append(invalid, decodeElement(t, g, lowOrder))
+			}
+		}
+
+		for _, bad := range invalid {
+			peers := randomElements(g, 3)
+			peers[2] = bad
+
+			shared, err := g.ECDHBatch(priv, peers)
+			if err == nil || shared != nil {
+				t.Fatal("expected error on invalid peer")
+			}
+
+			if err.Error() != "ECDH batch: peer 2: invalid peer public key" {
+				t.Fatalf("unexpected error %q", err)
+			}
+		}
+
+		for _, s := range []*crypto.Scalar{nil, g.NewScalar()} {
+			if _, err := g.ECDHBatch(s, randomElements(g, 2)); err == nil {
+				t.Fatal("expected error on invalid private scalar")
+			}
+		}
+	})
+}
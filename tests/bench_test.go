@@ -11,6 +11,8 @@ package group_test
 import (
 	"bytes"
 	"testing"
+
+	"github.com/bytemare/crypto"
 )
 
 func benchAll(b *testing.B, f func(*testing.B, *testGroup)) {
@@ -34,6 +36,51 @@ func BenchmarkPow(b *testing.B) {
 	})
 }
 
+func BenchmarkPowCT(b *testing.B) {
+	benchAll(b, func(b *testing.B, group *testGroup) {
+		base := group.group.NewScalar().Random()
+		exp := group.group.NewScalar().Random()
+		b.ResetTimer()
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_ = base.Copy().PowCT(exp)
+		}
+	})
+}
+
+func BenchmarkPowersOf(b *testing.B) {
+	const n = 32
+
+	benchAll(b, func(b *testing.B, group *testGroup) {
+		x := group.group.NewScalar().Random()
+		b.ResetTimer()
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_ = group.group.PowersOf(x, n)
+		}
+	})
+}
+
+func BenchmarkPowersOf_Pow(b *testing.B) {
+	const n = 32
+
+	benchAll(b, func(b *testing.B, group *testGroup) {
+		x := group.group.NewScalar().Random()
+		exponents := make([]*crypto.Scalar, n)
+		for i := range exponents {
+			exponents[i] = group.group.NewScalar().SetUInt64(uint64(i))
+		}
+
+		b.ResetTimer()
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			for _, e := range exponents {
+				_ = x.Copy().Pow(e)
+			}
+		}
+	})
+}
+
 func BenchmarkHashToGroup(b *testing.B) {
 	msg := make([]byte, 256)
 	dst := make([]byte, 10)
@@ -98,3 +145,219 @@ func BenchmarkMarshalUnmarshal(b *testing.B) {
 		}
 	})
 }
+
+func BenchmarkScalarMultVartime(b *testing.B) {
+	benchAll(b, func(b *testing.B, group *testGroup) {
+		priv := group.group.NewScalar().Random()
+		pub := group.group.Base().Multiply(group.group.NewScalar().Random())
+		b.ResetTimer()
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			pub = pub.MultiplyVartime(priv)
+		}
+	})
+}
+
+func BenchmarkNegate(b *testing.B) {
+	benchAll(b, func(b *testing.B, group *testGroup) {
+		p := group.group.Base()
+		b.ResetTimer()
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_ = p.Negate()
+		}
+	})
+}
+
+func BenchmarkScalarBaseMult_Decoded(b *testing.B) {
+	benchAll(b, func(b *testing.B, group *testGroup) {
+		priv := group.group.NewScalar().Random()
+		enc := group.group.Base().Encode()
+		b.ResetTimer()
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			base := group.group.NewElement()
+			if err := base.Decode(enc); err != nil {
+				b.Fatal(err)
+			}
+
+			_ = base.Multiply(priv)
+		}
+	})
+}
+
+func BenchmarkScalarBaseMult_Generator(b *testing.B) {
+	benchAll(b, func(b *testing.B, group *testGroup) {
+		priv := group.group.NewScalar().Random()
+		b.ResetTimer()
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_ = group.group.Generator().Multiply(priv)
+		}
+	})
+}
+
+func BenchmarkGroup_ScalarBaseMult(b *testing.B) {
+	benchAll(b, func(b *testing.B, group *testGroup) {
+		priv := group.group.NewScalar().Random()
+		b.ResetTimer()
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_ = group.group.ScalarBaseMult(priv)
+		}
+	})
+}
+
+func BenchmarkGroup_RandomElement(b *testing.B) {
+	benchAll(b, func(b *testing.B, group *testGroup) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_ = group.group.RandomElement()
+		}
+	})
+}
+
+func BenchmarkGroup_RandomElement_BaseMult(b *testing.B) {
+	benchAll(b, func(b *testing.B, group *testGroup) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_ = group.group.Base().Multiply(group.group.NewScalar().Random())
+		}
+	})
+}
+
+func BenchmarkGroup_RandomScalars(b *testing.B) {
+	benchAll(b, func(b *testing.B, group *testGroup) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_ = group.group.RandomScalars(64)
+		}
+	})
+}
+
+func BenchmarkGroup_RandomScalars_Random(b *testing.B) {
+	benchAll(b, func(b *testing.B, group *testGroup) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			for j := 0; j < 64; j++ {
+				_ = group.group.NewScalar().Random()
+			}
+		}
+	})
+}
+
+func BenchmarkScalarDouble(b *testing.B) {
+	benchAll(b, func(b *testing.B, group *testGroup) {
+		s := group.group.NewScalar().Random()
+		b.ResetTimer()
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			s.Double()
+		}
+	})
+}
+
+func BenchmarkScalarAdd(b *testing.B) {
+	benchAll(b, func(b *testing.B, group *testGroup) {
+		s := group.group.NewScalar().Random()
+		t := group.group.NewScalar().Random()
+		b.ResetTimer()
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			s.Add(t)
+		}
+	})
+}
+
+func BenchmarkScalarMulAdd(b *testing.B) {
+	benchAll(b, func(b *testing.B, group *testGroup) {
+		s := group.group.NewScalar().Random()
+		m := group.group.NewScalar().Random()
+		a := group.group.NewScalar().Random()
+		b.ResetTimer()
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			s.MulAdd(m, a)
+		}
+	})
+}
+
+func BenchmarkScalarEqual(b *testing.B) {
+	benchAll(b, func(b *testing.B, group *testGroup) {
+		s := group.group.NewScalar().Random()
+		t := s.Copy()
+		b.ResetTimer()
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			s.Equal(t)
+		}
+	})
+}
+
+func BenchmarkValidateEncodings(b *testing.B) {
+	benchAll(b, func(b *testing.B, group *testGroup) {
+		encodings := make([][]byte, 16)
+		for i := range encodings {
+			encodings[i] = group.group.Base().Multiply(group.group.NewScalar().Random()).Encode()
+		}
+
+		b.ResetTimer()
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_ = group.group.ValidateEncodings(encodings)
+		}
+	})
+}
+
+func BenchmarkScalarSum(b *testing.B) {
+	benchAll(b, func(b *testing.B, group *testGroup) {
+		scalars := randomScalars(group.group, 100)
+		b.ResetTimer()
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			sum := group.group.NewScalar()
+			for _, s := range scalars {
+				sum = sum.Copy().Add(s)
+			}
+		}
+	})
+}
+
+func BenchmarkScalarAccumulator(b *testing.B) {
+	benchAll(b, func(b *testing.B, group *testGroup) {
+		scalars := randomScalars(group.group, 100)
+		b.ResetTimer()
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			acc := group.group.NewScalarAccumulator()
+			for _, s := range scalars {
+				acc.Add(s)
+			}
+
+			_ = acc.Sum()
+		}
+	})
+}
+
+func BenchmarkBaseMultiplesEncoded(b *testing.B) {
+	benchAll(b, func(b *testing.B, group *testGroup) {
+		b.ResetTimer()
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_ = group.group.BaseMultiplesEncoded(256)
+		}
+	})
+}
+
+func BenchmarkTable_Multiply(b *testing.B) {
+	benchAll(b, func(b *testing.B, group *testGroup) {
+		table := group.group.PrecomputeTable(group.group.Base(), 4)
+		s := group.group.NewScalar().Random()
+
+		b.ResetTimer()
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_ = table.Multiply(s)
+		}
+	})
+}
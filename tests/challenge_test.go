@@ -0,0 +1,124 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (C) 2020-2023 Daniel Bourdrez. All Rights Reserved.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree or at
+// https://spdx.org/licenses/MIT.html
+
+package group_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/bytemare/crypto"
+)
+
+var testChallengeDST = []byte("challenge test DST")
+
+func TestChallengeFromElements(t *testing.T) {
+	testAllGroups(t, func(group *testGroup) {
+		a := group.group.Base().Multiply(group.group.NewScalar().Random())
+		b := group.group.Base().Multiply(group.group.NewScalar().Random())
+
+		c1 := group.group.ChallengeFromElements(testChallengeDST, a, b)
+		c2 := group.group.ChallengeFromElements(testChallengeDST, a, b)
+
+		// Deterministic
+		if c1.Equal(c2) != 1 {
+			t.Fatal(errExpectedEquality)
+		}
+
+		// Reordering the elements changes the challenge.
+		if c1.Equal(group.group.ChallengeFromElements(testChallengeDST, b, a)) == 1 {
+			t.Fatal(errUnExpectedEquality)
+		}
+
+		// Another DST changes the challenge.
+		if c1.Equal(group.group.ChallengeFromElements([]byte("another challenge DST"), a, b)) == 1 {
+			t.Fatal(errUnExpectedEquality)
+		}
+
+		// Adding an element changes the challenge, even if it's the identity.
+		if c1.Equal(group.group.ChallengeFromElements(testChallengeDST, a, b, group.group.NewElement())) == 1 {
+			t.Fatal(errUnExpectedEquality)
+		}
+
+		// An empty transcript is valid.
+		if group.group.ChallengeFromElements(testChallengeDST).IsZero() {
+			t.Fatal("unexpected zero challenge")
+		}
+	})
+}
+
+func TestChallengeScalar(t *testing.T) {
+	msg := []byte("message")
+
+	testAllGroups(t, func(group *testGroup) {
+		g := group.group
+		R := g.Base().Multiply(g.NewScalar().Random())
+		pub := g.Base().Multiply(g.NewScalar().Random())
+
+		c := g.ChallengeScalar(R, pub, msg, testChallengeDST)
+
+		// Deterministic
+		if c.Equal(g.ChallengeScalar(R, pub, msg, testChallengeDST)) != 1 {
+			t.Fatal(errExpectedEquality)
+		}
+
+		// The base point is bound.
+		input := slices.Concat(g.Base().Encode(), R.Encode(), pub.Encode(), msg)
+		if c.Equal(g.HashToScalar(input, testChallengeDST)) != 1 {
+			t.Fatal(errExpectedEquality)
+		}
+
+		input = slices.Concat(R.Encode(), pub.Encode(), msg)
+		if c.Equal(g.HashToScalar(input, testChallengeDST)) == 1 {
+			t.Fatal(errUnExpectedEquality)
+		}
+
+		// Changing any input changes the challenge.
+		other := g.Base().Multiply(g.NewScalar().Random())
+		for _, c2 := range []*crypto.Scalar{
+			g.ChallengeScalar(other, pub, msg, testChallengeDST),
+			g.ChallengeScalar(R, other, msg, testChallengeDST),
+			g.ChallengeScalar(pub, R, msg, testChallengeDST),
+			g.ChallengeScalar(R, pub, []byte("other message"), testChallengeDST),
+			g.ChallengeScalar(R, pub, nil, testChallengeDST),
+			g.ChallengeScalar(R, pub, msg, []byte("another challenge DST")),
+		} {
+			if c.Equal(c2) == 1 {
+				t.Fatal(errUnExpectedEquality)
+			}
+		}
+	})
+}
+
+func TestGroup_Commit(t *testing.T) {
+	msg := []byte("message")
+
+	testAllGroups(t, func(group *testGroup) {
+		g := group.group
+		r, R := g.Commit()
+
+		if r.IsZero() || R.Equal(g.Base().Multiply(r)) != 1 {
+			t.Fatal(errExpectedEquality)
+		}
+
+		// Fresh nonces
+		if r2, R2 := g.Commit(); r2.Equal(r) == 1 || R2.Equal(R) == 1 {
+			t.Fatal(errUnExpectedEquality)
+		}
+
+		// Schnorr proof of knowledge of x: z * Base == R + c * pub.
+		x := g.NewScalar().Random()
+		pub := g.Base().Multiply(x)
+		c := g.ChallengeScalar(R, pub, msg, testChallengeDST)
+		z := r.Copy().Add(c.Copy().Multiply(x))
+
+		if g.Base().Multiply(z).Equal(R.Copy().Add(pub.Copy().Multiply(c))) != 1 {
+			t.Fatal(errExpectedEquality)
+		}
+	})
+}
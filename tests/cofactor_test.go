@@ -0,0 +1,250 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (C) 2020-2023 Daniel Bourdrez. All Rights Reserved.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree or at
+// https://spdx.org/licenses/MIT.html
+
+package group_test
+
+import (
+	"testing"
+
+	"github.com/bytemare/crypto"
+)
+
+// edwards25519LowOrder are Edwards25519 points of order 8 and 2.
+var edwards25519LowOrder = []string{
+	"c7176a703d4dd84fba3c0b760d10670f2a2053fa2c39ccc64ec7fd7792ac037a",
+	"ecffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff7f",
+}
+
+func TestCofactoredEqual(t *testing.T) {
+	testAllGroups(t, func(group *testGroup) {
+		g := group.group
+		p := g.Base().Multiply(g.NewScalar().Random())
+		q := g.Base().Multiply(g.NewScalar().Random())
+
+		if g.CofactoredEqual(p, p.Copy()) != 1 {
+			t.Fatal(errExpectedEquality)
+		}
+
+		if g.CofactoredEqual(p, q) != 0 {
+			t.Fatal(errUnExpectedEquality)
+		}
+
+		if g.CofactoredEqual(g.NewElement(), g.NewElement()) != 1 {
+			t.Fatal(errExpectedEquality)
+		}
+
+		// The inputs are not modified.
+		if p.Equal(q) == 1 || p.IsIdentity() {
+			t.Fatal("unexpected modification of the input")
+		}
+	})
+}
+
+func TestCofactoredEqual_LowOrder(t *testing.T) {
+	g := crypto.Edwards25519Sha512
+	p := g.Base().Multiply(g.NewScalar().Random())
+
+	for _, lowOrder := range edwards25519LowOrder {
+		l := decodeElement(t, g, lowOrder)
+		q := p.Copy().Add(l)
+
+		// Strict equality distinguishes the points, but cofactored equality doesn't.
+		if p.Equal(q) != 0 {
+			t.Fatal(errUnExpectedEquality)
+		}
+
+		if g.CofactoredEqual(p, q) != 1 || g.CofactoredEqual(q, p) != 1 {
+			t.Fatal(errExpectedEquality)
+		}
+
+		if g.CofactoredEqual(l, g.NewElement()) != 1 {
+			t.Fatal(errExpectedEquality)
+		}
+
+		// RFC 8032 cofactored verification: [8][s]B = [8]R + [8][k]A, with a low-order component in R.
+		a := g.NewScalar().Random()
+		r := g.NewScalar().Random()
+		k := g.NewScalar().Random()
+		s := r.Copy().Add(k.Copy().Multiply(a))
+		R := g.Base().Multiply(r).Add(l)
+
+		left := g.Base().Multiply(s)
+		right := R.Copy().Add(g.Base().Multiply(a).Multiply(k))
+
+		if left.Equal(right) != 0 {
+			t.Fatal(errUnExpectedEquality)
+		}
+
+		if g.CofactoredEqual(left, right) != 1 {
+			t.Fatal(errExpectedEquality)
+		}
+	}
+}
+
+func TestSubgroupMembershipWitness(t *testing.T) {
+	g := crypto.Edwards25519Sha512
+	p := g.Base().Multiply(g.NewScalar().Random())
+
+	verify := func(e *crypto.Element, witness []byte) bool {
+		q, err := g.StringToPoint(witness)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return q.Double().Double().Double().Equal(e) == 1
+	}
+
+	for _, e := range []*crypto.Element{p, g.Base(), g.NewElement()} {
+		witness, ok := g.SubgroupMembershipWitness(e)
+		if !ok {
+			t.Fatal("expected element to be in the subgroup")
+		}
+
+		if len(witness) != g.ElementLength() || !verify(e, witness) {
+			t.Fatal("invalid witness")
+		}
+	}
+
+	// Low-order points, and points with a low-order component, are not in the subgroup.
+	for _, lowOrder := range edwards25519LowOrder {
+		l := decodeElement(t, g, lowOrder)
+
+		for _, e := range []*crypto.Element{l, p.Copy().Add(l)} {
+			if witness, ok := g.SubgroupMembershipWitness(e); ok || witness != nil {
+				t.Fatal("expected element not to be in the subgroup")
+			}
+		}
+	}
+
+	if _, ok := g.SubgroupMembershipWitness(nil); ok {
+		t.Fatal("expected nil element not to be in the subgroup")
+	}
+
+	// All elements of prime-order groups are in the subgroup, with an empty witness.
+	testAllGroups(t, func(group *testGroup) {
+		if group.group == crypto.Edwards25519Sha512 {
+			return
+		}
+
+		witness, ok := group.group.SubgroupMembershipWitness(group.group.Base().Multiply(group.group.NewScalar().Random()))
+		if !ok || len(witness) != 0 {
+			t.Fatal("expected element to be in the subgroup with an empty witness")
+		}
+	})
+}
+
+func TestElement_ClearCofactor(t *testing.T) {
+	testAllGroups(t, func(group *testGroup) {
+		g := group.group
+		p := g.Base().Multiply(g.NewScalar().Random())
+
+		cofactor := uint64(1)
+		if g == crypto.Edwards25519Sha512 {
+			cofactor = 8
+		}
+
+		expected := p.Copy().Multiply(g.NewScalar().SetUInt64(cofactor))
+		if p.Copy().ClearCofactor().Equal(expected) != 1 {
+			t.Fatal(errExpectedEquality)
+		}
+
+		if !g.NewElement().ClearCofactor().IsIdentity() {
+			t.Fatal("expected identity")
+		}
+	})
+}
+
+func TestElement_ClearCofactor_LowOrder(t *testing.T) {
+	g := crypto.Edwards25519Sha512
+	p := g.Base().Multiply(g.NewScalar().Random())
+	expected := p.Copy().Multiply(g.NewScalar().SetUInt64(8))
+
+	for _, lowOrder := range edwards25519LowOrder {
+		l := decodeElement(t, g, lowOrder)
+		if !l.Copy().ClearCofactor().IsIdentity() {
+			t.Fatal("expected identity")
+		}
+
+		// The low-order component is removed, and the result is in the prime-order subgroup.
+		q := p.Copy().Add(l)
+		if q.IsValid() {
+			t.Fatal("expected point outside of the prime-order subgroup")
+		}
+
+		q.ClearCofactor()
+		if !q.IsValid() || q.Equal(expected) != 1 {
+			t.Fatal(errExpectedEquality)
+		}
+	}
+}
+
+func TestElement_IsInPrimeOrderSubgroup(t *testing.T) {
+	testAllGroups(t, func(group *testGroup) {
+		g := group.group
+
+		h := g.HashToGroup(testHashToGroupInput, testHashToGroupDST)
+
+		for _, e := range []*crypto.Element{g.NewElement(), g.Base(), g.RandomElement(), h} {
+			if !e.IsInPrimeOrderSubgroup() {
+				t.Fatal("expected element in the prime-order subgroup")
+			}
+		}
+	})
+}
+
+func TestElement_IsInPrimeOrderSubgroup_LowOrder(t *testing.T) {
+	g := crypto.Edwards25519Sha512
+	p := g.RandomElement()
+
+	for _, lowOrder := range edwards25519LowOrder {
+		l := decodeElement(t, g, lowOrder)
+
+		if l.IsInPrimeOrderSubgroup() {
+			t.Fatal("unexpected low-order element in the prime-order subgroup")
+		}
+
+		q := p.Copy().Add(l)
+		if q.IsInPrimeOrderSubgroup() {
+			t.Fatal("unexpected element with a low-order component in the prime-order subgroup")
+		}
+
+		// Clearing the cofactor removes the low-order component.
+		if !q.ClearCofactor().IsInPrimeOrderSubgroup() {
+			t.Fatal("expected element in the prime-order subgroup")
+		}
+	}
+}
+
+func TestElement_HasPrimeOrder(t *testing.T) {
+	testAllGroups(t, func(group *testGroup) {
+		g := group.group
+
+		h := g.HashToGroup(testHashToGroupInput, testHashToGroupDST)
+
+		for _, e := range []*crypto.Element{g.Base(), g.RandomElement(), h} {
+			if !e.HasPrimeOrder() || e.HasPrimeOrder() != e.IsValid() {
+				t.Fatal("expected element of prime order")
+			}
+		}
+
+		if g.NewElement().HasPrimeOrder() {
+			t.Fatal("unexpected prime order for the identity element")
+		}
+	})
+
+	g := crypto.Edwards25519Sha512
+	p := g.RandomElement()
+
+	for _, lowOrder := range edwards25519LowOrder {
+		l := decodeElement(t, g, lowOrder)
+
+		if l.HasPrimeOrder() || p.Copy().Add(l).HasPrimeOrder() {
+			t.Fatal("unexpected prime order for a low-order or mixed-order element")
+		}
+	}
+}
@@ -0,0 +1,94 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (C) 2020-2023 Daniel Bourdrez. All Rights Reserved.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree or at
+// https://spdx.org/licenses/MIT.html
+
+package group_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/bytemare/crypto"
+)
+
+func randomScalars(g crypto.Group, n int) []*crypto.Scalar {
+	s := make([]*crypto.Scalar, n)
+	for i := range s {
+		s[i] = g.NewScalar().Random()
+	}
+
+	return s
+}
+
+func randomElements(g crypto.Group, n int) []*crypto.Element {
+	e := make([]*crypto.Element, n)
+	for i := range e {
+		e[i] = g.Base().Multiply(g.NewScalar().Random())
+	}
+
+	return e
+}
+
+func TestVectorCommit(t *testing.T) {
+	testAllGroups(t, func(group *testGroup) {
+		g := group.group
+		a, b := randomScalars(g, 4), randomScalars(g, 3)
+		G, H := randomElements(g, 4), randomElements(g, 3)
+		blind := g.NewScalar().Random()
+		B := g.Base().Multiply(g.NewScalar().Random())
+
+		c, err := g.VectorCommit(a, b, G, H, blind, B)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// Naive computation
+		ref := B.Copy().Multiply(blind)
+		for i := range a {
+			ref.Add(G[i].Copy().Multiply(a[i]))
+		}
+
+		for i := range b {
+			ref.Add(H[i].Copy().Multiply(b[i]))
+		}
+
+		if c.Equal(ref) != 1 {
+			t.Fatal(errExpectedEquality)
+		}
+
+		// Empty vectors yield blind * B
+		c, err = g.VectorCommit(nil, nil, nil, nil, blind, B)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if c.Equal(B.Copy().Multiply(blind)) != 1 {
+			t.Fatal(errExpectedEquality)
+		}
+	})
+}
+
+func TestVectorCommit_LengthMismatch(t *testing.T) {
+	errLengths := errors.New("mismatching input lengths")
+
+	testAllGroups(t, func(group *testGroup) {
+		g := group.group
+		blind := g.NewScalar().Random()
+
+		want := "vector commitment: " + errLengths.Error() + " (2 values for 3 generators)"
+		if _, err := g.VectorCommit(randomScalars(g, 2), nil, randomElements(g, 3), nil, blind, g.Base()); err == nil ||
+			err.Error() != want {
+			t.Fatalf("expected error %q, got %v", want, err)
+		}
+
+		want = "vector commitment: " + errLengths.Error() + " (1 blinding values for 2 generators)"
+		if _, err := g.VectorCommit(nil, randomScalars(g, 1), nil, randomElements(g, 2), blind, g.Base()); err == nil ||
+			err.Error() != want {
+			t.Fatalf("expected error %q, got %v", want, err)
+		}
+	})
+}
@@ -0,0 +1,128 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (C) 2020-2023 Daniel Bourdrez. All Rights Reserved.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree or at
+// https://spdx.org/licenses/MIT.html
+
+package group_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/bytemare/crypto"
+)
+
+func TestScalar_CondSet(t *testing.T) {
+	testAllGroups(t, func(group *testGroup) {
+		g := group.group
+		a, b := g.NewScalar().Random(), g.NewScalar().Random()
+
+		for _, other := range []*crypto.Scalar{b, g.NewScalar(), g.NewScalar().One(), a} {
+			if a.Copy().CondSet(other, 0).Equal(a) != 1 {
+				t.Fatal(errExpectedEquality)
+			}
+
+			if a.Copy().CondSet(other, 1).Equal(other) != 1 {
+				t.Fatal(errExpectedEquality)
+			}
+		}
+
+		// The argument is not modified.
+		c := b.Copy()
+		g.NewScalar().CondSet(b, 1).Add(a)
+
+		if c.Equal(b) != 1 {
+			t.Fatal(errExpectedEquality)
+		}
+
+		// nil is treated as zero.
+		if !a.Copy().CondSet(nil, 1).IsZero() || a.Copy().CondSet(nil, 0).Equal(a) != 1 {
+			t.Fatal(errExpectedEquality)
+		}
+	})
+}
+
+func TestElement_CondSet(t *testing.T) {
+	testAllGroups(t, func(group *testGroup) {
+		g := group.group
+		p := g.Base().Multiply(g.NewScalar().Random())
+		q := g.Base().Multiply(g.NewScalar().Random())
+
+		for _, other := range []*crypto.Element{q, g.NewElement(), g.Base(), p} {
+			for _, e := range []*crypto.Element{p, g.NewElement()} {
+				if e.Copy().CondSet(other, 0).Equal(e) != 1 {
+					t.Fatal(errExpectedEquality)
+				}
+
+				if r := e.Copy().CondSet(other, 1); r.Equal(other) != 1 || r.Hex() != other.Hex() {
+					t.Fatal(errExpectedEquality)
+				}
+			}
+		}
+
+		// The selected element is usable in further operations, and the argument is not modified.
+		r := g.NewElement().CondSet(g.Base(), 1).Multiply(g.NewScalar().SetUInt64(2))
+		if r.Equal(g.Base().Double()) != 1 {
+			t.Fatal(errExpectedEquality)
+		}
+
+		c := q.Copy()
+		g.NewElement().CondSet(q, 1).Double()
+
+		if c.Equal(q) != 1 {
+			t.Fatal(errExpectedEquality)
+		}
+
+		// nil is treated as the identity.
+		if !p.Copy().CondSet(nil, 1).IsIdentity() || p.Copy().CondSet(nil, 0).Equal(p) != 1 {
+			t.Fatal(errExpectedEquality)
+		}
+	})
+}
+
+// minCondSetTimes returns the fastest of several interleaved timings of f with cond 0 and with cond 1.
+func minCondSetTimes(f func(cond int)) (t0, t1 time.Duration) {
+	t0, t1 = time.Duration(1<<63-1), time.Duration(1<<63-1)
+
+	for i := 0; i < 200; i++ {
+		for cond, best := range []*time.Duration{&t0, &t1} {
+			start := time.Now()
+			f(cond)
+
+			if d := time.Since(start); d < *best {
+				*best = d
+			}
+		}
+	}
+
+	return t0, t1
+}
+
+func TestCondSet_Timing(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping timing test in short mode")
+	}
+
+	testAllGroups(t, func(group *testGroup) {
+		g := group.group
+		s, other := g.NewScalar().Random(), g.NewScalar().Random()
+		p, q := g.Base().Multiply(s), g.Base().Multiply(other)
+
+		timings := map[string]func(cond int){
+			"scalar":  func(cond int) { s.Copy().CondSet(other, cond) },
+			"element": func(cond int) { p.Copy().CondSet(q, cond) },
+		}
+
+		for name, f := range timings {
+			t0, t1 := minCondSetTimes(f)
+
+			// The bounds are generous to avoid flakiness on noisy machines.
+			if 2*t0 < t1 || t0 > 2*t1 {
+				t.Fatalf("%s: timings depend on the condition: %v for 0 and %v for 1", name, t0, t1)
+			}
+		}
+	})
+}
@@ -0,0 +1,374 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (C) 2020-2023 Daniel Bourdrez. All Rights Reserved.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree or at
+// https://spdx.org/licenses/MIT.html
+
+package group_test
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/bytemare/crypto"
+)
+
+func TestDecodeElementCT(t *testing.T) {
+	testAllGroups(t, func(group *testGroup) {
+		e := group.group.Base().Multiply(group.group.NewScalar().Random())
+
+		d, ok := group.group.DecodeElementCT(e.Encode())
+		if ok != 1 {
+			t.Fatal("expected successful decoding")
+		}
+
+		if d.Equal(e) != 1 {
+			t.Fatal(errExpectedEquality)
+		}
+
+		for _, b := range [][]byte{
+			nil,
+			decodeHex(t, group.identity),
+			bytes.Repeat([]byte{0xff}, group.elementLength-1),
+			append(e.Encode(), 0x00),
+		} {
+			d, ok = group.group.DecodeElementCT(b)
+			if ok != 0 {
+				t.Fatalf("expected failed decoding of %x", b)
+			}
+
+			if !d.IsIdentity() {
+				t.Fatal("expected identity")
+			}
+		}
+	})
+}
+
+// minDecodingTimes returns the fastest of several timed decodings of each input, interleaving both inputs so that
+// changes in the machine's load affect them alike.
+func minDecodingTimes(g crypto.Group, valid, invalid []byte) (v, i time.Duration) {
+	v, i = time.Duration(1<<63-1), time.Duration(1<<63-1)
+
+	for n := 0; n < 500; n++ {
+		start := time.Now()
+		_, _ = g.DecodeElementCT(valid)
+		v = min(v, time.Since(start))
+
+		start = time.Now()
+		_, _ = g.DecodeElementCT(invalid)
+		i = min(i, time.Since(start))
+	}
+
+	return v, i
+}
+
+// TestDecodeElementCT_Timing checks that invalid inputs don't return earlier than valid ones, by comparing the
+// fastest decoding times. This only detects an early return, i.e. the timing difference the balancing is meant to
+// remove, and is no statistical test for leakage: invalid inputs are expected to be slower, since they cost a failed
+// decoding and a valid one, which is twice the cost of a valid one for Edwards25519 where the identity is rejected
+// after a full decoding.
+func TestDecodeElementCT_Timing(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping timing test in short mode")
+	}
+
+	testAllGroups(t, func(group *testGroup) {
+		// The base point is the valid encoding the balancing decodes, so that the decoding times of valid inputs don't
+		// depend on the value of the element, as they do for Secp256k1.
+		valid := group.group.Base().Encode()
+
+		// An invalid encoding of the same length.
+		invalid := bytes.Repeat([]byte{0xff}, group.elementLength)
+		if _, ok := group.group.DecodeElementCT(invalid); ok != 0 {
+			invalid = decodeHex(t, group.identity)
+		}
+
+		v, i := minDecodingTimes(group.group, valid, invalid)
+
+		// Allow 25% of noise below the valid decoding time, which is still far from the cost of an early return, and up
+		// to the cost of two and a half decodings above.
+		if 4*i < 3*v || 2*i > 5*v {
+			t.Fatalf("decoding times differ too much: %v for valid and %v for invalid input", v, i)
+		}
+	})
+}
+
+func TestDecodeElements(t *testing.T) {
+	testAllGroups(t, func(group *testGroup) {
+		g := group.group
+		elements := randomElements(g, 5)
+
+		encodings := make([][]byte, len(elements))
+		for i, e := range elements {
+			encodings[i] = e.Encode()
+		}
+
+		decoded, err := g.DecodeElements(encodings)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if len(decoded) != len(elements) {
+			t.Fatalf("expected %d elements, got %d", len(elements), len(decoded))
+		}
+
+		for i, e := range decoded {
+			if e.Equal(elements[i]) != 1 {
+				t.Fatalf("%d: %s", i, errExpectedEquality)
+			}
+		}
+
+		// The elements are independent.
+		decoded[0].Double()
+		if decoded[1].Equal(elements[1]) != 1 {
+			t.Fatal(errExpectedEquality)
+		}
+
+		if decoded, err = g.DecodeElements(nil); err != nil || len(decoded) != 0 {
+			t.Fatalf("unexpected result for empty input: %v, %v", decoded, err)
+		}
+
+		// The first failing index is reported.
+		encodings[3] = decodeHex(t, group.identity)
+		encodings[4] = nil
+
+		decoded, err = g.DecodeElements(encodings)
+		expectDecodeError(t, err, "element", 3)
+
+		if decoded != nil {
+			t.Fatal("expected nil elements on error")
+		}
+	})
+}
+
+func TestDecodeScalars(t *testing.T) {
+	testAllGroups(t, func(group *testGroup) {
+		g := group.group
+		scalars := randomScalars(g, 5)
+
+		encodings := make([][]byte, len(scalars))
+		for i, s := range scalars {
+			encodings[i] = s.Encode()
+		}
+
+		decoded, err := g.DecodeScalars(encodings)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if len(decoded) != len(scalars) {
+			t.Fatalf("expected %d scalars, got %d", len(scalars), len(decoded))
+		}
+
+		for i, s := range decoded {
+			if s.Equal(scalars[i]) != 1 {
+				t.Fatalf("%d: %s", i, errExpectedEquality)
+			}
+		}
+
+		if decoded, err = g.DecodeScalars(nil); err != nil || len(decoded) != 0 {
+			t.Fatalf("unexpected result for empty input: %v, %v", decoded, err)
+		}
+
+		encodings[1] = bytes.Repeat([]byte{0xff}, group.scalarLength)
+		encodings[2] = nil
+
+		decoded, err = g.DecodeScalars(encodings)
+		expectDecodeError(t, err, "scalar", 1)
+
+		if decoded != nil {
+			t.Fatal("expected nil scalars on error")
+		}
+	})
+}
+
+func expectDecodeError(t *testing.T, err error, kind string, index int) {
+	t.Helper()
+
+	if err == nil {
+		t.Fatal("expected error")
+	}
+
+	var decodeErr *crypto.DecodeError
+	if !errors.As(err, &decodeErr) || decodeErr.Index() != index {
+		t.Fatalf("expected a decoding error at index %d, got %v", index, err)
+	}
+
+	var indexed interface{ Index() int }
+	if !errors.As(err, &indexed) || indexed.Index() != index {
+		t.Fatalf("expected an error exposing index %d, got %v", index, err)
+	}
+
+	if errors.Unwrap(err) == nil {
+		t.Fatal("expected a wrapped error")
+	}
+
+	if prefix := fmt.Sprintf("%s %d: ", kind, index); !strings.HasPrefix(err.Error(), prefix) {
+		t.Fatalf("expected error message prefix %q, got %q", prefix, err)
+	}
+}
+
+func TestDecodeElementWithPolicy(t *testing.T) {
+	g := crypto.Edwards25519Sha512
+	errLowOrder := errors.New("decode with policy: element has low order")
+	errNonCanonical := errors.New("decode with policy: non-canonical element encoding")
+	errIdentity := errors.New("decode with policy: element Decode: infinity/identity point")
+
+	const (
+		identity = "0100000000000000000000000000000000000000000000000000000000000000"
+		// y = p + 3, which reduces to a valid point of large order.
+		nonCanonical = "f0ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff7f"
+		// y = p, which reduces to a valid point of order 4.
+		nonCanonicalLowOrder = "edffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff7f"
+	)
+
+	var (
+		none      = crypto.DecodePolicy{}
+		identityP = crypto.DecodePolicy{RejectIdentity: true}
+		lowOrderP = crypto.DecodePolicy{RejectLowOrder: true}
+		canonical = crypto.DecodePolicy{RequireCanonical: true}
+		strict    = crypto.DecodePolicy{RejectIdentity: true, RejectLowOrder: true, RequireCanonical: true}
+	)
+
+	tests := []struct {
+		name     string
+		encoding string
+		policy   crypto.DecodePolicy
+		err      error
+	}{
+		{"valid, no policy", g.Base().Multiply(g.NewScalar().Random()).Hex(), none, nil},
+		{"valid, strict", g.Base().Multiply(g.NewScalar().Random()).Hex(), strict, nil},
+		{"identity, no policy", identity, none, nil},
+		{"identity, canonical", identity, canonical, nil},
+		{"identity, reject identity", identity, identityP, errIdentity},
+		{"identity, reject low order", identity, lowOrderP, errLowOrder},
+		{"low order, no policy", edwards25519LowOrder[0], none, nil},
+		{"low order, reject identity", edwards25519LowOrder[0], identityP, nil},
+		{"low order, canonical", edwards25519LowOrder[0], canonical, nil},
+		{"low order, reject low order", edwards25519LowOrder[0], lowOrderP, errLowOrder},
+		{"low order, strict", edwards25519LowOrder[1], strict, errLowOrder},
+		{"non-canonical, no policy", nonCanonical, none, nil},
+		{"non-canonical, reject identity", nonCanonical, identityP, nil},
+		{"non-canonical, reject low order", nonCanonical, lowOrderP, nil},
+		{"non-canonical, canonical", nonCanonical, canonical, errNonCanonical},
+		{"non-canonical, strict", nonCanonical, strict, errNonCanonical},
+		{"non-canonical low order, no policy", nonCanonicalLowOrder, none, nil},
+		{"non-canonical low order, canonical", nonCanonicalLowOrder, canonical, errNonCanonical},
+		{
+			"non-canonical low order, canonical and identity",
+			nonCanonicalLowOrder,
+			crypto.DecodePolicy{RejectIdentity: true, RequireCanonical: true},
+			errNonCanonical,
+		},
+		{"non-canonical low order, strict", nonCanonicalLowOrder, strict, errLowOrder},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e, err := g.DecodeElementWithPolicy(decodeHex(t, tt.encoding), tt.policy)
+
+			switch {
+			case tt.err == nil && err != nil:
+				t.Fatalf("unexpected error: %v", err)
+			case tt.err == nil && e.Equal(decodePoint(t, g, tt.encoding)) != 1:
+				t.Fatal(errExpectedEquality)
+			case tt.err != nil && err == nil:
+				t.Fatal("expected error")
+			case tt.err != nil && err.Error() != tt.err.Error():
+				t.Fatalf("expected error %q, got %q", tt.err, err)
+			}
+		})
+	}
+}
+
+func TestDecodeElementWithPolicy_AllGroups(t *testing.T) {
+	testAllGroups(t, func(group *testGroup) {
+		g := group.group
+		e := g.Base().Multiply(g.NewScalar().Random())
+		strict := crypto.DecodePolicy{RejectIdentity: true, RejectLowOrder: true, RequireCanonical: true}
+
+		d, err := g.DecodeElementWithPolicy(e.Encode(), strict)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if d.Equal(e) != 1 {
+			t.Fatal(errExpectedEquality)
+		}
+
+		id := g.PointToString(g.NewElement())
+		if d, err = g.DecodeElementWithPolicy(id, crypto.DecodePolicy{}); err != nil || !d.IsIdentity() {
+			t.Fatalf("expected identity, got %v", err)
+		}
+
+		if _, err = g.DecodeElementWithPolicy(id, strict); err == nil {
+			t.Fatal("expected error on identity")
+		}
+
+		if _, err = g.DecodeElementWithPolicy(nil, crypto.DecodePolicy{}); err == nil {
+			t.Fatal("expected error on nil input")
+		}
+	})
+}
+
+// decodePoint decodes the encoding like StringToPoint, accepting the identity and non-canonical encodings.
+func decodePoint(t *testing.T, g crypto.Group, h string) *crypto.Element {
+	t.Helper()
+
+	e, err := g.StringToPoint(decodeHex(t, h))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return e
+}
+
+func TestValidateEncodings(t *testing.T) {
+	testAllGroups(t, func(group *testGroup) {
+		g := group.group
+		valid := g.Base().Multiply(g.NewScalar().Random()).Encode()
+		encodings := [][]byte{
+			valid,
+			nil,
+			valid[:len(valid)-1],
+			append(bytes.Clone(valid), 0x00),
+			g.PointToString(g.NewElement()),
+			g.Base().Encode(),
+		}
+		invalid := map[int]bool{1: true, 2: true, 3: true, 4: true}
+
+		if g == crypto.Edwards25519Sha512 {
+			// A low-order point, and a point with a low-order component.
+			lowOrder := decodeElement(t, g, edwards25519LowOrder[0])
+			encodings = append(encodings, lowOrder.Encode(), lowOrder.Add(g.Base()).Encode())
+			invalid[6], invalid[7] = true, true
+		}
+
+		errs := g.ValidateEncodings(encodings)
+		if len(errs) != len(encodings) {
+			t.Fatalf("expected %d errors, got %d", len(encodings), len(errs))
+		}
+
+		for i, err := range errs {
+			if !invalid[i] {
+				if err != nil {
+					t.Fatalf("%d: unexpected error: %v", i, err)
+				}
+
+				continue
+			}
+
+			expectDecodeError(t, err, "element", i)
+		}
+
+		if errs = g.ValidateEncodings(nil); len(errs) != 0 {
+			t.Fatal("expected no errors for an empty input")
+		}
+	})
+}
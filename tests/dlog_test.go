@@ -0,0 +1,86 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (C) 2020-2023 Daniel Bourdrez. All Rights Reserved.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree or at
+// https://spdx.org/licenses/MIT.html
+
+package group_test
+
+import (
+	"bytes"
+	"testing"
+)
+
+var testDLogDST = []byte("DLog proof test DST")
+
+func TestDLog(t *testing.T) {
+	testAllGroups(t, func(group *testGroup) {
+		x := group.group.NewScalar().Random()
+
+		P, proof := group.group.ProveDLog(x, testDLogDST)
+		if P.Equal(group.group.Base().Multiply(x)) != 1 {
+			t.Fatal(errExpectedEquality)
+		}
+
+		if len(proof) != group.elementLength+group.scalarLength {
+			t.Fatalf("unexpected proof length %d", len(proof))
+		}
+
+		if !group.group.VerifyDLog(P, proof, testDLogDST) {
+			t.Fatal("expected valid proof")
+		}
+
+		// Two proofs for the same secret differ, but are both valid.
+		_, proof2 := group.group.ProveDLog(x, testDLogDST)
+		if bytes.Equal(proof, proof2) {
+			t.Fatal(errUnExpectedEquality)
+		}
+
+		if !group.group.VerifyDLog(P, proof2, testDLogDST) {
+			t.Fatal("expected valid proof")
+		}
+	})
+}
+
+func TestDLog_Invalid(t *testing.T) {
+	testAllGroups(t, func(group *testGroup) {
+		x := group.group.NewScalar().Random()
+		P, proof := group.group.ProveDLog(x, testDLogDST)
+
+		// Tampered commitment and response
+		for _, i := range []int{1, group.elementLength + 1, len(proof) - 1} {
+			tampered := bytes.Clone(proof)
+			tampered[i] ^= 0x01
+
+			if group.group.VerifyDLog(P, tampered, testDLogDST) {
+				t.Fatalf("expected invalid proof for tampered byte %d", i)
+			}
+		}
+
+		// Another element
+		other := group.group.Base().Multiply(group.group.NewScalar().Random())
+		if group.group.VerifyDLog(other, proof, testDLogDST) {
+			t.Fatal("expected invalid proof for another element")
+		}
+
+		// Another DST
+		if group.group.VerifyDLog(P, proof, []byte("another DLog proof DST")) {
+			t.Fatal("expected invalid proof for another DST")
+		}
+
+		// Wrong lengths and nil element
+		if group.group.VerifyDLog(P, proof[:len(proof)-1], testDLogDST) {
+			t.Fatal("expected invalid proof for truncated proof")
+		}
+
+		if group.group.VerifyDLog(P, nil, testDLogDST) {
+			t.Fatal("expected invalid proof for nil proof")
+		}
+
+		if group.group.VerifyDLog(nil, proof, testDLogDST) {
+			t.Fatal("expected invalid proof for nil element")
+		}
+	})
+}
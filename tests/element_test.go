@@ -9,10 +9,13 @@
 package group_test
 
 import (
+	"bytes"
+	"crypto/ecdh"
 	"encoding/hex"
 	"errors"
 	"log"
 	"math/big"
+	"slices"
 	"testing"
 
 	"github.com/bytemare/crypto"
@@ -109,6 +112,12 @@ func TestElement_WrongInput(t *testing.T) {
 			t.Fatal(err)
 		}
 
+		if err := testPanic(errWrongGroup, internal.ErrCastElement, func() {
+			_ = element.AddMany(group.group.Base(), alternativeGroup.NewElement())
+		}); err != nil {
+			t.Fatal(err)
+		}
+
 		if err := testPanic(errWrongGroup, internal.ErrCastElement,
 			exec(element.Subtract, alternativeGroup.NewElement())); err != nil {
 			t.Fatal(err)
@@ -132,6 +141,38 @@ func TestElement_WrongInput(t *testing.T) {
 	}
 }
 
+func TestElement_EqualStrict(t *testing.T) {
+	testAllGroups(t, func(group *testGroup) {
+		g := group.group
+		e := g.Base().Multiply(g.NewScalar().Random())
+
+		if e.EqualStrict(e.Copy()) != 1 || g.NewElement().EqualStrict(g.NewElement()) != 1 {
+			t.Fatal(errExpectedEquality)
+		}
+
+		if e.EqualStrict(g.Base()) != 0 || e.EqualStrict(g.NewElement()) != 0 {
+			t.Fatal(errUnExpectedEquality)
+		}
+
+		if e.EqualStrict(nil) != 0 {
+			t.Fatal(errUnExpectedEquality)
+		}
+
+		// Elements of all other groups, including the identity and base point, are not equal.
+		for _, other := range testTable {
+			if other.group == g {
+				continue
+			}
+
+			for _, o := range []*crypto.Element{other.group.NewElement(), other.group.Base()} {
+				if g.NewElement().EqualStrict(o) != 0 || g.Base().EqualStrict(o) != 0 {
+					t.Fatalf("%s: %s", other.name, errUnExpectedEquality)
+				}
+			}
+		}
+	})
+}
+
 func TestElement_EncodedLength(t *testing.T) {
 	testAllGroups(t, func(group *testGroup) {
 		id := group.group.NewElement().Identity().Encode()
@@ -263,6 +304,65 @@ func TestElement_Vectors_Add(t *testing.T) {
 	})
 }
 
+func TestElement_AddMany(t *testing.T) {
+	testAllGroups(t, func(group *testGroup) {
+		g := group.group
+
+		for _, k := range []int{0, 1, 2, 7, 16} {
+			bases := make([]*crypto.Element, k)
+			for i := range bases {
+				bases[i] = g.Base()
+			}
+
+			if g.NewElement().AddMany(bases...).Equal(g.Base().Multiply(g.NewScalar().SetUInt64(uint64(k)))) != 1 {
+				t.Fatalf("%d: %s", k, errExpectedEquality)
+			}
+		}
+
+		// Equivalent to repeated Add, with nil and identity elements skipped.
+		p, q := g.RandomElement(), g.RandomElement()
+		sum := p.Copy().Add(q).Add(p).Add(g.Base())
+
+		if p.Copy().AddMany(q, nil, g.NewElement(), p, g.Base()).Equal(sum) != 1 {
+			t.Fatal(errExpectedEquality)
+		}
+
+		// The inputs are not modified.
+		c := p.Copy()
+		if p.Copy().AddMany(p, p).Equal(p.Copy().Multiply(g.NewScalar().SetUInt64(3))) != 1 || p.Equal(c) != 1 {
+			t.Fatal(errExpectedEquality)
+		}
+
+		if p.Copy().AddMany().Equal(p) != 1 {
+			t.Fatal(errExpectedEquality)
+		}
+	})
+}
+
+func TestElement_DoubleN(t *testing.T) {
+	testAllGroups(t, func(group *testGroup) {
+		g := group.group
+
+		for _, p := range []*crypto.Element{g.Base(), g.RandomElement(), g.NewElement()} {
+			for k := 0; k <= 10; k++ {
+				expected := p.Copy().Multiply(g.NewScalar().SetUInt64(1 << k))
+				if p.Copy().DoubleN(k).Equal(expected) != 1 {
+					t.Fatalf("%d: %s", k, errExpectedEquality)
+				}
+			}
+
+			// Negative values are a no-op.
+			if p.Copy().DoubleN(-1).Equal(p) != 1 {
+				t.Fatal(errExpectedEquality)
+			}
+		}
+
+		if g.Base().DoubleN(1).Equal(g.Base().Double()) != 1 {
+			t.Fatal(errExpectedEquality)
+		}
+	})
+}
+
 func TestElement_Vectors_Double(t *testing.T) {
 	testAllGroups(t, func(group *testGroup) {
 		tables := [][]int{
@@ -303,6 +403,171 @@ func TestElement_Vectors_Mult(t *testing.T) {
 	})
 }
 
+func TestGroup_ScalarBaseMult(t *testing.T) {
+	testAllGroups(t, func(group *testGroup) {
+		g := group.group
+
+		for _, s := range []*crypto.Scalar{
+			g.NewScalar(),
+			g.NewScalar().One(),
+			g.NewScalar().SetUInt64(2),
+			g.NewScalar().Random(),
+		} {
+			decoded := g.NewElement()
+			if err := decoded.Decode(g.Base().Encode()); err != nil {
+				t.Fatal(err)
+			}
+
+			p := g.ScalarBaseMult(s)
+			if p.Equal(decoded.Multiply(s)) != 1 {
+				t.Fatal(errExpectedEquality)
+			}
+
+			// The result is not flagged as the base point anymore.
+			if p.Copy().Multiply(s).Equal(g.ScalarBaseMult(s.Copy().Multiply(s))) != 1 {
+				t.Fatal(errExpectedEquality)
+			}
+		}
+
+		if !g.ScalarBaseMult(nil).IsIdentity() {
+			t.Fatal("expected identity")
+		}
+	})
+}
+
+func TestElement_Multiply_Base(t *testing.T) {
+	testAllGroups(t, func(group *testGroup) {
+		g := group.group
+		s := g.NewScalar()
+		two := g.NewScalar().SetUInt64(2)
+		decodedBase := decodeElement(t, g, group.basePoint)
+
+		for i := 1; 2*i <= len(group.multBase); i++ {
+			s.SetUInt64(uint64(i))
+			expected := decodeElement(t, g, group.multBase[i-1])
+			doubled := decodeElement(t, g, group.multBase[2*i-1])
+
+			bases := map[string]*crypto.Element{
+				"Base":   g.Base(),
+				"reset":  g.NewElement().Base(),
+				"decode": decodedBase.Copy(),
+				"copy":   g.Base().Copy(),
+				"set":    g.NewElement().Set(g.Base()),
+				"add":    g.Base().Add(g.NewElement()),
+			}
+
+			for name, base := range bases {
+				if base.Multiply(s).Equal(expected) != 1 {
+					t.Fatalf("%s %d: %s", name, i, errExpectedEquality)
+				}
+
+				// The result is not the base point anymore.
+				if base.Multiply(two).Equal(doubled) != 1 {
+					t.Fatalf("%s %d: %s", name, i, errExpectedEquality)
+				}
+			}
+
+			// Elements derived from the base point
+			if g.Base().Double().Multiply(s).Equal(doubled) != 1 {
+				t.Fatal(errExpectedEquality)
+			}
+
+			if g.Base().Negate().Multiply(s).Negate().Equal(expected) != 1 {
+				t.Fatal(errExpectedEquality)
+			}
+
+			if g.Base().Subtract(g.Base().Negate()).Multiply(s).Equal(doubled) != 1 {
+				t.Fatal(errExpectedEquality)
+			}
+
+			if g.NewElement().Set(g.Base().Double()).Multiply(s).Equal(doubled) != 1 {
+				t.Fatal(errExpectedEquality)
+			}
+
+			if !g.Base().Identity().Multiply(s).IsIdentity() {
+				t.Fatal("expected identity")
+			}
+		}
+	})
+}
+
+func TestElement_MultiplyVartime(t *testing.T) {
+	testAllGroups(t, func(group *testGroup) {
+		g := group.group
+		scalars := []*crypto.Scalar{
+			g.NewScalar(),
+			g.NewScalar().One(),
+			g.NewScalar().SetUInt64(2),
+			g.NewScalar().SetUInt64(15),
+			g.NewScalar().SetUInt64(16),
+			g.ScalarFromInt64(-1),
+		}
+		scalars = append(scalars, randomScalars(g, 10)...)
+
+		points := append([]*crypto.Element{g.Base(), g.NewElement()}, randomElements(g, 3)...)
+		if g == crypto.Edwards25519Sha512 {
+			for _, lowOrder := range edwards25519LowOrder {
+				points = append(points, decodeElement(t, g, lowOrder), g.Base().Add(decodeElement(t, g, lowOrder)))
+			}
+		}
+
+		for _, p := range points {
+			for _, s := range scalars {
+				v := p.Copy().MultiplyVartime(s)
+				c := p.Copy().Multiply(s)
+
+				if v.Equal(c) != 1 || v.Hex() != c.Hex() {
+					t.Fatalf("%s * %s: %s", p.Hex(), s.Hex(), errExpectedEquality)
+				}
+			}
+		}
+
+		if !g.Base().MultiplyVartime(nil).IsIdentity() {
+			t.Fatal("expected identity")
+		}
+	})
+}
+
+func TestElement_IsValid(t *testing.T) {
+	testAllGroups(t, func(group *testGroup) {
+		g := group.group
+
+		for _, e := range append([]*crypto.Element{g.Base(), g.Base().Negate()}, randomElements(g, 5)...) {
+			if !e.IsValid() {
+				t.Fatalf("expected %s to be valid", e.Hex())
+			}
+
+			if !decodeElement(t, g, e.Hex()).IsValid() {
+				t.Fatalf("expected decoded %s to be valid", e.Hex())
+			}
+		}
+
+		if g.NewElement().IsValid() || g.Base().Subtract(g.Base()).IsValid() {
+			t.Fatal("expected identity to be invalid")
+		}
+
+		if g != crypto.Edwards25519Sha512 {
+			return
+		}
+
+		// Decoding accepts low-order points, but IsValid doesn't.
+		for _, lowOrder := range edwards25519LowOrder {
+			l := decodeElement(t, g, lowOrder)
+			if l.IsValid() {
+				t.Fatalf("expected low-order point %s to be invalid", lowOrder)
+			}
+
+			if g.Base().Add(l).IsValid() {
+				t.Fatal("expected point with a low-order component to be invalid")
+			}
+
+			if !g.Base().Add(l).Double().Double().Double().IsValid() {
+				t.Fatal("expected cleared point to be valid")
+			}
+		}
+	})
+}
+
 func TestElement_Arithmetic(t *testing.T) {
 	testAllGroups(t, func(group *testGroup) {
 		elementTestEqual(t, group.group)
@@ -513,3 +778,119 @@ func elementTestIdentity(t *testing.T, g crypto.Group) {
 		t.Fatal(errExpectedIdentity)
 	}
 }
+
+func TestElement_EncodeUncompressed(t *testing.T) {
+	curves := map[crypto.Group]ecdh.Curve{
+		crypto.P256Sha256: ecdh.P256(),
+		crypto.P384Sha384: ecdh.P384(),
+		crypto.P521Sha512: ecdh.P521(),
+	}
+
+	testAllGroups(t, func(group *testGroup) {
+		g := group.group
+		e := g.Base().Multiply(g.NewScalar().Random())
+
+		if g == crypto.Ristretto255Sha512 || g == crypto.Edwards25519Sha512 {
+			if err := testPanic("unsupported", errors.New("operation not supported by this group"), func() {
+				_ = e.EncodeUncompressed()
+			}); err != nil {
+				t.Fatal(err)
+			}
+
+			expected := "element DecodeUncompressed: operation not supported by this group"
+			if err := g.NewElement().DecodeUncompressed(e.Encode()); err == nil || err.Error() != expected {
+				t.Fatalf("expected error %q, got %v", expected, err)
+			}
+
+			return
+		}
+
+		enc := e.EncodeUncompressed()
+		if len(enc) != 2*g.ElementLength()-1 || enc[0] != 4 {
+			t.Fatalf("unexpected uncompressed encoding %x", enc)
+		}
+
+		// The x-coordinate is that of the compressed encoding.
+		if !bytes.Equal(enc[1:g.ElementLength()], e.XCoordinate()) {
+			t.Fatal(errExpectedEquality)
+		}
+
+		d := g.NewElement()
+		if err := d.DecodeUncompressed(enc); err != nil {
+			t.Fatal(err)
+		}
+
+		if d.Equal(e) != 1 {
+			t.Fatal(errExpectedEquality)
+		}
+
+		// Same for the negation, which only differs in y.
+		n := e.Copy().Negate()
+		if err := d.DecodeUncompressed(n.EncodeUncompressed()); err != nil || d.Equal(n) != 1 {
+			t.Fatal(errExpectedEquality)
+		}
+
+		// Interoperability with crypto/ecdh.
+		if curve, ok := curves[g]; ok {
+			priv, err := curve.NewPrivateKey(g.NewScalar().Random().Encode())
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			s := g.NewScalar()
+			if err = s.Decode(priv.Bytes()); err != nil {
+				t.Fatal(err)
+			}
+
+			if !bytes.Equal(g.Base().Multiply(s).EncodeUncompressed(), priv.PublicKey().Bytes()) {
+				t.Fatal(errExpectedEquality)
+			}
+		}
+
+		// The identity is encoded as zeros, and rejected at decoding.
+		id := g.NewElement().EncodeUncompressed()
+		if len(id) != len(enc) || !bytes.Equal(id, make([]byte, len(id))) {
+			t.Fatalf("unexpected identity encoding %x", id)
+		}
+
+		if err := d.DecodeUncompressed(id); err == nil {
+			t.Fatal("expected error on identity")
+		}
+
+		// Invalid encodings.
+		wrongY := slices.Clone(enc)
+		wrongY[len(wrongY)-1] ^= 1
+		compressed := e.Encode()
+		wrongPrefix := slices.Clone(enc)
+		wrongPrefix[0] = 2
+
+		for _, invalid := range [][]byte{nil, enc[:len(enc)-1], compressed, wrongY, wrongPrefix} {
+			if err := d.DecodeUncompressed(invalid); err == nil {
+				t.Fatalf("expected error on %x", invalid)
+			}
+		}
+	})
+}
+
+func TestSecp256k1_EncodeUncompressed_Base(t *testing.T) {
+	expected := "0479be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798" +
+		"483ada7726a3c4655da4fbfc0e1108a8fd17b448a68554199c47d08ffb10d4b8"
+
+	if enc := hex.EncodeToString(crypto.Secp256k1.Base().EncodeUncompressed()); enc != expected {
+		t.Fatalf("expected %s, got %s", expected, enc)
+	}
+}
+
+func TestSecp256k1_Negate_Base(t *testing.T) {
+	// The base point has an even y-coordinate, so its negation has an odd one.
+	expected := "0379be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798"
+
+	if enc := hex.EncodeToString(crypto.Secp256k1.Base().Negate().Encode()); enc != expected {
+		t.Fatalf("expected %s, got %s", expected, enc)
+	}
+
+	decoded := decodeElement(t, crypto.Secp256k1, expected)
+	if decoded.Negate().Equal(crypto.Secp256k1.Base()) != 1 {
+		t.Fatal(errExpectedEquality)
+	}
+}
@@ -13,6 +13,7 @@ import (
 	"encoding"
 	"encoding/hex"
 	"encoding/json"
+	"encoding/xml"
 	"errors"
 	"fmt"
 	"strings"
@@ -30,6 +31,8 @@ type serde interface {
 	DecodeHex(h string) error
 	encoding.BinaryMarshaler
 	encoding.BinaryUnmarshaler
+	encoding.TextMarshaler
+	encoding.TextUnmarshaler
 }
 
 type (
@@ -43,6 +46,7 @@ var encodeTesters = []makeEncodeTest{
 	binaryTest,
 	hexTest,
 	jsonTest,
+	textTest,
 }
 
 func toEncoder(s serde) byteEncoder {
@@ -106,6 +110,14 @@ func jsonTest(t *encodingTest) *encodingTest {
 	return t
 }
 
+func textTest(t *encodingTest) *encodingTest {
+	t.sourceEncoder = t.source.MarshalText
+	t.receiverDecoder = t.receiver.UnmarshalText
+	t.receiverEncoder = t.receiver.MarshalText
+
+	return t
+}
+
 func (t *encodingTest) run() error {
 	encoded, err := t.sourceEncoder()
 	if err != nil {
@@ -204,6 +216,10 @@ func testDecodeEmpty(t *testing.T, s serde) {
 		t.Fatal("expected error on empty string")
 	}
 
+	if err := s.UnmarshalText(nil); err == nil {
+		t.Fatal("expected error on UnmarshalText() with nil input")
+	}
+
 	if err := json.Unmarshal(nil, s); err == nil {
 		t.Fatal("expected error")
 	}
@@ -231,6 +247,39 @@ func testDecodingHexFails(t *testing.T, thing1, thing2 serde) {
 	}
 }
 
+func TestEncoding_Text_Struct(t *testing.T) {
+	type keys struct {
+		XMLName xml.Name        `xml:"keys"`
+		Secret  *crypto.Scalar  `xml:"secret"`
+		Public  *crypto.Element `xml:"public,attr"`
+	}
+
+	testAllGroups(t, func(group *testGroup) {
+		g := group.group
+		secret := g.NewScalar().Random()
+		source := keys{Secret: secret, Public: g.Base().Multiply(secret)}
+
+		encoded, err := xml.Marshal(source)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		expected := fmt.Sprintf("<keys public=%q><secret>%s</secret></keys>", source.Public.Hex(), secret.Hex())
+		if string(encoded) != expected {
+			t.Fatalf("unexpected encoding %s", encoded)
+		}
+
+		receiver := keys{Secret: g.NewScalar(), Public: g.NewElement()}
+		if err = xml.Unmarshal(encoded, &receiver); err != nil {
+			t.Fatal(err)
+		}
+
+		if receiver.Secret.Equal(source.Secret) != 1 || receiver.Public.Equal(source.Public) != 1 {
+			t.Fatal(errExpectedEquality)
+		}
+	})
+}
+
 func TestEncoding_Hex_Fails(t *testing.T) {
 	testAllGroups(t, func(group *testGroup) {
 		g := group.group
@@ -263,3 +312,47 @@ func TestEncoding_Hex_Fails(t *testing.T) {
 		}
 	})
 }
+
+func TestGroup_FromHex(t *testing.T) {
+	testAllGroups(t, func(group *testGroup) {
+		g := group.group
+		scalar := g.NewScalar().Random()
+		element := g.Base().Multiply(scalar)
+
+		s, err := g.ScalarFromHex(scalar.Hex())
+		if err != nil || s.Equal(scalar) != 1 {
+			t.Fatalf("unexpected error on valid encoding: %v", err)
+		}
+
+		e, err := g.ElementFromHex(element.Hex())
+		if err != nil || e.Equal(element) != 1 {
+			t.Fatalf("unexpected error on valid encoding: %v", err)
+		}
+
+		// Wrong lengths are rejected before decoding, with the expected and actual lengths.
+		for _, h := range []string{"", scalar.Hex()[1:], scalar.Hex() + "00", strings.Repeat("_", 2*g.ScalarLength()+1)} {
+			expected := fmt.Sprintf("scalar from hex: invalid hex length: expected %d characters, got %d",
+				2*g.ScalarLength(), len(h))
+			if _, err = g.ScalarFromHex(h); err == nil || err.Error() != expected {
+				t.Fatalf("expected error %q, got %v", expected, err)
+			}
+		}
+
+		for _, h := range []string{"", element.Hex()[1:], element.Hex() + "00"} {
+			expected := fmt.Sprintf("element from hex: invalid hex length: expected %d characters, got %d",
+				2*g.ElementLength(), len(h))
+			if _, err = g.ElementFromHex(h); err == nil || err.Error() != expected {
+				t.Fatalf("expected error %q, got %v", expected, err)
+			}
+		}
+
+		// Invalid hex and invalid scalar encodings of the right length still fail.
+		if _, err = g.ScalarFromHex(strings.Repeat("_", 2*g.ScalarLength())); err == nil {
+			t.Fatal("expected error on invalid hex")
+		}
+
+		if _, err = g.ScalarFromHex(strings.Repeat("ff", g.ScalarLength())); err == nil {
+			t.Fatal("expected error on invalid scalar")
+		}
+	})
+}
@@ -9,11 +9,19 @@
 package group_test
 
 import (
+	"bytes"
 	"encoding/hex"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
+	"math/big"
+	"slices"
+	"strings"
 	"testing"
 
+	"github.com/bytemare/hash2curve"
+
 	"github.com/bytemare/crypto"
 )
 
@@ -62,6 +70,55 @@ func TestNonAvailability(t *testing.T) {
 	}
 }
 
+func TestCheckAvailable(t *testing.T) {
+	testAllGroups(t, func(group *testGroup) {
+		if err := group.group.CheckAvailable(); err != nil {
+			t.Errorf("'%s' is not available, but should be: %v", group.group.String(), err)
+		}
+	})
+
+	errNotImpl := errors.New("group not yet implemented")
+	errInvalidID := errors.New("invalid group identifier")
+
+	tests := []struct {
+		err error
+		id  crypto.Group
+	}{
+		{errNotImpl, crypto.Group(2)}, // decaf448
+		{errInvalidID, crypto.Group(0)},
+		{errInvalidID, crypto.Secp256k1 + 1},
+		{errInvalidID, crypto.Group(255)},
+	}
+
+	for _, test := range tests {
+		if err := test.id.CheckAvailable(); err == nil || err.Error() != test.err.Error() {
+			t.Errorf("%d: expected error %q, got %v", test.id, test.err, err)
+		}
+	}
+}
+
+func TestGroup_SameAs(t *testing.T) {
+	testAllGroups(t, func(group *testGroup) {
+		g := group.group
+		if !g.SameAs(g) {
+			t.Fatal(errExpectedEquality)
+		}
+
+		for _, other := range testTable {
+			if other.group != g && (g.SameAs(other.group) || other.group.SameAs(g)) {
+				t.Fatalf("%v: %s", other.group, errUnExpectedEquality)
+			}
+		}
+	})
+
+	// Unavailable groups are never the same, not even as themselves.
+	for _, g := range []crypto.Group{crypto.Group(0), crypto.Group(2), crypto.Secp256k1 + 1} {
+		if g.SameAs(g) || g.SameAs(crypto.Ristretto255Sha512) || crypto.Ristretto255Sha512.SameAs(g) {
+			t.Fatalf("%d: %s", g, errUnExpectedEquality)
+		}
+	}
+}
+
 func TestGroup_Base(t *testing.T) {
 	testAllGroups(t, func(group *testGroup) {
 		if group.group.Base().Hex() != group.basePoint {
@@ -72,6 +129,271 @@ func TestGroup_Base(t *testing.T) {
 	})
 }
 
+func TestGroup_OrderBigInt(t *testing.T) {
+	testAllGroups(t, func(group *testGroup) {
+		g := group.group
+
+		expected, ok := new(big.Int).SetString(g.Order(), 10)
+		if !ok {
+			t.Fatalf("order %q is not in base 10", g.Order())
+		}
+
+		order := g.OrderBigInt()
+		if order.Cmp(expected) != 0 || !bytes.Equal(g.OrderBytes(), expected.Bytes()) {
+			t.Fatal(errExpectedEquality)
+		}
+
+		// The order minus one is the largest scalar.
+		maxScalar := g.NewScalar().Subtract(g.NewScalar().One()).Encode()
+		if g == crypto.Ristretto255Sha512 || g == crypto.Edwards25519Sha512 {
+			slices.Reverse(maxScalar)
+		}
+
+		if new(big.Int).SetBytes(maxScalar).Cmp(order.Sub(order, big.NewInt(1))) != 0 {
+			t.Fatal(errExpectedEquality)
+		}
+
+		// Modifying the returned values does not affect the cached order.
+		g.OrderBytes()[0] ^= 0xff
+
+		if g.OrderBigInt().Cmp(expected) != 0 || !bytes.Equal(g.OrderBytes(), expected.Bytes()) {
+			t.Fatal(errExpectedEquality)
+		}
+	})
+}
+
+func TestGroup_ScalarFieldOps(t *testing.T) {
+	testAllGroups(t, func(group *testGroup) {
+		g := group.group
+		f := g.ScalarFieldOps()
+		a, b := g.NewScalar().Random(), g.NewScalar().Random()
+		x, _ := new(big.Int).SetString(a.DebugString(), 10)
+		y, _ := new(big.Int).SetString(b.DebugString(), 10)
+		res := new(big.Int)
+
+		if f.Add(res, x, y).String() != a.Copy().Add(b).DebugString() {
+			t.Fatal(errExpectedEquality)
+		}
+
+		if f.Mul(res, x, y).String() != a.Copy().Multiply(b).DebugString() {
+			t.Fatal(errExpectedEquality)
+		}
+
+		if f.Inv(res, x).String() != a.Copy().Invert().DebugString() {
+			t.Fatal(errExpectedEquality)
+		}
+
+		if f.Exp(res, x, big.NewInt(5)).String() != a.Copy().Pow(g.NewScalar().SetUInt64(5)).DebugString() {
+			t.Fatal(errExpectedEquality)
+		}
+
+		// Results are reduced, and the inverse of 0 is 0.
+		if f.Add(res, g.OrderBigInt(), big.NewInt(-1)).Cmp(g.OrderBigInt().Sub(g.OrderBigInt(), big.NewInt(1))) != 0 {
+			t.Fatal(errExpectedEquality)
+		}
+
+		if f.Inv(res, big.NewInt(0)).Sign() != 0 || f.Inv(res, g.OrderBigInt()).Sign() != 0 {
+			t.Fatal("expected 0")
+		}
+
+		// The inputs can alias the result.
+		z := new(big.Int).Set(x)
+		if f.Mul(z, z, z).Cmp(f.Mul(res, x, x)) != 0 {
+			t.Fatal(errExpectedEquality)
+		}
+	})
+}
+
+func TestGroup_Generator(t *testing.T) {
+	testAllGroups(t, func(group *testGroup) {
+		g := group.group
+		gen := g.Generator()
+
+		if gen.Hex() != group.basePoint || gen.Equal(g.Base()) != 1 {
+			t.Fatal(errExpectedEquality)
+		}
+
+		// Modifying the returned element does not affect the cached generator.
+		gen.Double()
+		gen2 := g.Generator().Add(g.Base())
+
+		if gen2.Equal(gen) != 1 {
+			t.Fatal(errExpectedEquality)
+		}
+
+		if g.Generator().Hex() != group.basePoint {
+			t.Fatal(errExpectedEquality)
+		}
+
+		s := g.NewScalar().Random()
+		if g.Generator().Multiply(s).Equal(g.Base().Multiply(s)) != 1 {
+			t.Fatal(errExpectedEquality)
+		}
+	})
+
+	if err := testPanic("invalid group", errors.New("invalid group identifier"), func() {
+		_ = crypto.Group(0).Generator()
+	}); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestGroup_RandomElement(t *testing.T) {
+	const samples = 256
+
+	testAllGroups(t, func(group *testGroup) {
+		g := group.group
+		seen := make(map[string]bool, samples)
+		ones := 0
+
+		for i := 0; i < samples; i++ {
+			e := g.RandomElement()
+			if e.IsIdentity() || !e.IsValid() {
+				t.Fatal("expected a valid non-identity element")
+			}
+
+			enc := string(e.Encode())
+			if seen[enc] {
+				t.Fatal(errUnExpectedEquality)
+			}
+
+			seen[enc] = true
+
+			// The second byte of the encoding holds bits of a coordinate, which should be uniformly distributed.
+			ones += int(enc[1] & 1)
+		}
+
+		// This fails with a probability lower than 2^-40 for uniform elements.
+		if ones < samples/4 || ones > 3*samples/4 {
+			t.Fatalf("unexpected distribution: %d/%d", ones, samples)
+		}
+	})
+}
+
+func TestGroup_FromReader(t *testing.T) {
+	testAllGroups(t, func(group *testGroup) {
+		g := group.group
+		seed := bytes.Repeat([]byte{0x42}, 256)
+
+		s1, err := g.NewScalarFromReader(bytes.NewReader(seed))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		s2, err := g.NewScalarFromReader(bytes.NewReader(seed))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		expected := g.NewScalar()
+		if err = expected.SetWideBytes(seed[:2*g.ScalarLength()]); err != nil {
+			t.Fatal(err)
+		}
+
+		if s1.Equal(s2) != 1 || s1.Equal(expected) != 1 || s1.IsZero() {
+			t.Fatal(errExpectedEquality)
+		}
+
+		e1, err := g.NewElementFromReader(bytes.NewReader(seed))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		e2, err := g.NewElementFromReader(bytes.NewReader(seed))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if e1.Equal(e2) != 1 || e1.IsIdentity() || !e1.IsValid() {
+			t.Fatal(errExpectedEquality)
+		}
+
+		// Zero bytes are skipped for scalars.
+		zeros := append(make([]byte, 2*g.ScalarLength()), seed...)
+		if s, err := g.NewScalarFromReader(bytes.NewReader(zeros)); err != nil || s.Equal(expected) != 1 {
+			t.Fatalf("expected the zero bytes to be skipped: %v", err)
+		}
+
+		// Short readers fail.
+		if _, err = g.NewScalarFromReader(bytes.NewReader(make([]byte, 2*g.ScalarLength()))); !errors.Is(err, io.EOF) {
+			t.Fatalf("expected EOF, got %v", err)
+		}
+
+		if _, err = g.NewScalarFromReader(bytes.NewReader(seed[:3])); !errors.Is(err, io.ErrUnexpectedEOF) {
+			t.Fatalf("expected unexpected EOF, got %v", err)
+		}
+
+		if _, err = g.NewElementFromReader(bytes.NewReader(nil)); !errors.Is(err, io.EOF) {
+			t.Fatalf("expected EOF, got %v", err)
+		}
+	})
+}
+
+func TestGroup_RandomScalars(t *testing.T) {
+	const n = 128
+
+	testAllGroups(t, func(group *testGroup) {
+		g := group.group
+		seen := make(map[string]bool, 2*n)
+
+		for i := 0; i < 2; i++ {
+			scalars := g.RandomScalars(n)
+			if len(scalars) != n {
+				t.Fatalf("expected %d scalars, got %d", n, len(scalars))
+			}
+
+			for _, s := range scalars {
+				if s.IsZero() {
+					t.Fatal("unexpected zero scalar")
+				}
+
+				if seen[s.Hex()] {
+					t.Fatal(errUnExpectedEquality)
+				}
+
+				seen[s.Hex()] = true
+			}
+		}
+
+		for _, l := range []int{0, -1} {
+			if scalars := g.RandomScalars(l); scalars == nil || len(scalars) != 0 {
+				t.Fatalf("expected an empty slice for %d", l)
+			}
+		}
+	})
+}
+
+func TestGroupFromString(t *testing.T) {
+	testAllGroups(t, func(group *testGroup) {
+		g, err := crypto.GroupFromString(group.group.String())
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if g != group.group {
+			t.Fatalf("expected %v, got %v", group.group, g)
+		}
+
+		if group.group == crypto.Ristretto255Sha512 {
+			return
+		}
+
+		nu := strings.TrimSuffix(group.group.String(), "RO_") + "NU_"
+		if g, err = crypto.GroupFromString(nu); err != nil || g != group.group {
+			t.Fatalf("expected %v for %q, got %v (%v)", group.group, nu, g, err)
+		}
+	})
+
+	errInvalidID := errors.New("invalid group identifier")
+	for _, id := range []string{
+		"", "P256", "p256_XMD:SHA-256_SSWU_RO_", "P256_XMD:SHA-256_SSWU_RO", "decaf448_XOF:SHAKE256_D448MAP_RO_",
+	} {
+		if _, err := crypto.GroupFromString(id); err == nil || err.Error() != errInvalidID.Error() {
+			t.Fatalf("expected error %q for %q, got %v", errInvalidID, id, err)
+		}
+	}
+}
+
 func TestDST(t *testing.T) {
 	app := "app"
 	version := uint8(1)
@@ -93,6 +415,90 @@ func TestDST(t *testing.T) {
 	})
 }
 
+func TestDSTEncode(t *testing.T) {
+	testAllGroups(t, func(group *testGroup) {
+		g := group.group
+		_, nu := g.Suites()
+		prefix := strings.TrimSuffix(string(g.MakeDST("app", 1)), g.String())
+
+		if res := string(g.MakeDSTEncode("app", 1)); res != prefix+nu {
+			t.Fatalf("Wrong DST. want %q, got %q", prefix+nu, res)
+		}
+
+		if (g == crypto.Ristretto255Sha512) != bytes.Equal(g.MakeDSTEncode("app", 1), g.MakeDST("app", 1)) {
+			t.Fatal("expected distinct DSTs for groups with an encode-to-curve suite")
+		}
+	})
+}
+
+func TestSubDST(t *testing.T) {
+	root := []byte("root-DST")
+
+	testAllGroups(t, func(group *testGroup) {
+		g := group.group
+
+		expected := "0008" + hex.EncodeToString(root) + "0003" + hex.EncodeToString([]byte("abc")) + "0000"
+		if hex.EncodeToString(g.SubDST(root, "abc", "")) != expected {
+			t.Fatalf("unexpected sub-DST %x", g.SubDST(root, "abc", ""))
+		}
+
+		if !bytes.Equal(g.SubDST(root, "a", "b"), g.SubDST(root, "a", "b")) {
+			t.Fatal(errExpectedEquality)
+		}
+
+		// Distinct roots and label paths, some of which would collide without length prefixes.
+		paths := []struct {
+			root   []byte
+			labels []string
+		}{
+			{root, nil},
+			{root, []string{""}},
+			{root, []string{"", ""}},
+			{root, []string{"a"}},
+			{root, []string{"a", ""}},
+			{root, []string{"", "a"}},
+			{root, []string{"ab", "c"}},
+			{root, []string{"a", "bc"}},
+			{root, []string{"abc"}},
+			{root, []string{"b", "a"}},
+			{root, []string{"a", "b"}},
+			{root, []string{"a\x00\x01b"}},
+			{root, []string{"a", "\x00\x01b"}},
+			{[]byte("root-DST\x00\x01a"), nil},
+			{[]byte("root-DSTa"), nil},
+			{[]byte("root-DS"), []string{"Ta"}},
+		}
+
+		seen := make(map[string]int, len(paths))
+		for i, path := range paths {
+			dst := string(g.SubDST(path.root, path.labels...))
+			if j, ok := seen[dst]; ok {
+				t.Fatalf("paths %d and %d yield the same DST %x", j, i, dst)
+			}
+
+			seen[dst] = i
+		}
+
+		// The sub-DST is usable, and separates domains.
+		if g.HashToScalar(nil, g.SubDST(root, "a")).Equal(g.HashToScalar(nil, g.SubDST(root, "b"))) == 1 {
+			t.Fatal(errUnExpectedEquality)
+		}
+
+		if err := testPanic("zero length DST", errZeroLenDST, func() {
+			_ = g.SubDST(nil, "a")
+		}); err != nil {
+			t.Fatal(err)
+		}
+
+		errDSTLength := errors.New("DST component is longer than 65535 bytes")
+		if err := testPanic("long label", errDSTLength, func() {
+			_ = g.SubDST(root, string(make([]byte, 65536)))
+		}); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
 func TestGroup_String(t *testing.T) {
 	testAllGroups(t, func(group *testGroup) {
 		res := group.group.String()
@@ -180,6 +586,78 @@ func TestHashToScalar_NoDST(t *testing.T) {
 	})
 }
 
+func TestHashToScalars(t *testing.T) {
+	errScalarCount := errors.New("too many scalars for a single expand_message_xmd")
+
+	// The byte length each scalar takes in the expansion.
+	scalarExpandLength := map[crypto.Group]int{
+		crypto.Ristretto255Sha512: 64,
+		crypto.P256Sha256:         48,
+		crypto.P384Sha384:         72,
+		crypto.P521Sha512:         98,
+		crypto.Edwards25519Sha512: 48,
+		crypto.Secp256k1:          48,
+	}
+
+	testAllGroups(t, func(group *testGroup) {
+		g := group.group
+		sv := decodeScalar(t, g, group.hashToCurve.hashToScalar)
+
+		// A count of 1 is the same as HashToScalar.
+		s := g.HashToScalars(group.hashToCurve.input, group.hashToCurve.dst, 1)
+		if len(s) != 1 || s[0].Equal(sv) != 1 {
+			t.Fatal(errExpectedEquality)
+		}
+
+		scalars := g.HashToScalars(group.hashToCurve.input, group.hashToCurve.dst, 3)
+		if len(scalars) != 3 {
+			t.Fatalf("expected 3 scalars, got %d", len(scalars))
+		}
+
+		// The outputs are distinct and deterministic, and depend on the count.
+		for _, s := range scalars {
+			if s.Equal(sv) == 1 {
+				t.Fatal(errUnExpectedEquality)
+			}
+		}
+
+		if scalars[0].Equal(scalars[1]) == 1 || scalars[1].Equal(scalars[2]) == 1 || scalars[0].Equal(scalars[2]) == 1 {
+			t.Fatal(errUnExpectedEquality)
+		}
+
+		again := g.HashToScalars(group.hashToCurve.input, group.hashToCurve.dst, 3)
+		for i := range scalars {
+			if again[i].Equal(scalars[i]) != 1 {
+				t.Fatal(errExpectedEquality)
+			}
+		}
+
+		for _, n := range []int{0, -1} {
+			if s = g.HashToScalars(testHashToGroupInput, testHashToGroupDST, n); s == nil || len(s) != 0 {
+				t.Fatalf("expected an empty slice for count = %d", n)
+			}
+		}
+
+		// The largest count a single expansion supports, and one more.
+		maxCount := 255 * group.hash.Size() / scalarExpandLength[g]
+		if s = g.HashToScalars(testHashToGroupInput, testHashToGroupDST, maxCount); len(s) != maxCount {
+			t.Fatalf("expected %d scalars, got %d", maxCount, len(s))
+		}
+
+		if err := testPanic("too many scalars", errScalarCount, func() {
+			_ = g.HashToScalars(testHashToGroupInput, testHashToGroupDST, maxCount+1)
+		}); err != nil {
+			t.Fatal(err)
+		}
+
+		if err := testPanic("nil dst", errZeroLenDST, func() {
+			_ = g.HashToScalars(testHashToGroupInput, nil, 2)
+		}); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
 func TestHashToGroup(t *testing.T) {
 	testAllGroups(t, func(group *testGroup) {
 		ev := decodeElement(t, group.group, group.hashToCurve.hashToGroup)
@@ -191,6 +669,19 @@ func TestHashToGroup(t *testing.T) {
 	})
 }
 
+func TestEncodeToGroup_NotHashToGroup(t *testing.T) {
+	testAllGroups(t, func(group *testGroup) {
+		g := group.group
+		e := g.EncodeToGroup(testHashToGroupInput, testHashToGroupDST)
+		h := g.HashToGroup(testHashToGroupInput, testHashToGroupDST)
+
+		// Only Ristretto255 has no encode-to-curve suite, and uses the hash-to-curve one.
+		if (e.Equal(h) == 1) != (g == crypto.Ristretto255Sha512) {
+			t.Fatalf("unexpected EncodeToGroup output %s", e.Hex())
+		}
+	})
+}
+
 func TestHashToGroup_NoDST(t *testing.T) {
 	testAllGroups(t, func(group *testGroup) {
 		data := []byte("input data")
@@ -210,3 +701,320 @@ func TestHashToGroup_NoDST(t *testing.T) {
 		}
 	})
 }
+
+func TestHashToGroupWithSecLength(t *testing.T) {
+	errUnsupported := errors.New("operation not supported by this group")
+	errSecLength := errors.New("security length is lower than the group's minimum")
+	secLengths := map[crypto.Group]int{
+		crypto.P256Sha256: 48,
+		crypto.P384Sha384: 72,
+		crypto.P521Sha512: 98,
+		crypto.Secp256k1:  48,
+	}
+
+	testAllGroups(t, func(group *testGroup) {
+		g := group.group
+
+		secLength, ok := secLengths[g]
+		if !ok {
+			if err := testPanic("unsupported group", errUnsupported, func() {
+				_ = g.HashToGroupWithSecLength(testHashToGroupInput, testHashToGroupDST, 64)
+			}); err != nil {
+				t.Fatal(err)
+			}
+
+			return
+		}
+
+		// The default security length yields the output of HashToGroup.
+		e := g.HashToGroupWithSecLength(testHashToGroupInput, testHashToGroupDST, secLength)
+		if e.Equal(g.HashToGroup(testHashToGroupInput, testHashToGroupDST)) != 1 {
+			t.Fatal(errExpectedEquality)
+		}
+
+		// A longer one yields another valid element, deterministically.
+		e = g.HashToGroupWithSecLength(testHashToGroupInput, testHashToGroupDST, secLength+16)
+		if !e.IsValid() || e.Equal(g.HashToGroup(testHashToGroupInput, testHashToGroupDST)) == 1 {
+			t.Fatal(errUnExpectedEquality)
+		}
+
+		if e.Equal(g.HashToGroupWithSecLength(testHashToGroupInput, testHashToGroupDST, secLength+16)) != 1 {
+			t.Fatal(errExpectedEquality)
+		}
+
+		for _, l := range []int{secLength - 1, 0, -1} {
+			if err := testPanic("low security length", errSecLength, func() {
+				_ = g.HashToGroupWithSecLength(testHashToGroupInput, testHashToGroupDST, l)
+			}); err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		if err := testPanic("nil dst", errZeroLenDST, func() {
+			_ = g.HashToGroupWithSecLength(testHashToGroupInput, nil, secLength)
+		}); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+func TestHashToGroupIntermediate(t *testing.T) {
+	errUnsupported := errors.New("operation not supported by this group")
+
+	testAllGroups(t, func(group *testGroup) {
+		g := group.group
+
+		if g == crypto.Ristretto255Sha512 {
+			if err := testPanic("unsupported group", errUnsupported, func() {
+				_, _, _ = g.HashToGroupIntermediate(testHashToGroupInput, testHashToGroupDST)
+			}); err != nil {
+				t.Fatal(err)
+			}
+
+			return
+		}
+
+		p, q0, q1 := g.HashToGroupIntermediate(testHashToGroupInput, testHashToGroupDST)
+		if p.Equal(g.HashToGroup(testHashToGroupInput, testHashToGroupDST)) != 1 {
+			t.Fatal(errExpectedEquality)
+		}
+
+		// p is the sum of q0 and q1 after cofactor clearing.
+		if p.Equal(q0.Copy().Add(q1).ClearCofactor()) != 1 || q0.Equal(q1) == 1 {
+			t.Fatal(errExpectedEquality)
+		}
+
+		if err := testPanic("nil dst", errZeroLenDST, func() {
+			_, _, _ = g.HashToGroupIntermediate(testHashToGroupInput, nil)
+		}); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+func TestGroup_MapUniformToElement(t *testing.T) {
+	uniformLength := map[crypto.Group]int{
+		crypto.Ristretto255Sha512: 64,
+		crypto.P256Sha256:         96,
+		crypto.P384Sha384:         144,
+		crypto.P521Sha512:         196,
+		crypto.Edwards25519Sha512: 96,
+		crypto.Secp256k1:          96,
+	}
+
+	testAllGroups(t, func(group *testGroup) {
+		g := group.group
+		length := uniformLength[g]
+
+		// Expanding then mapping is the same as HashToGroup.
+		for _, dst := range [][]byte{testHashToGroupDST, group.hashToCurve.dst} {
+			uniform := hash2curve.ExpandXMD(g.HashFunc(), testHashToGroupInput, dst, uint(length))
+
+			e, err := g.MapUniformToElement(uniform)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if e.Equal(g.HashToGroup(testHashToGroupInput, dst)) != 1 {
+				t.Fatal(errExpectedEquality)
+			}
+		}
+
+		for _, l := range []int{0, length - 1, length + 1} {
+			expected := fmt.Sprintf("map uniform: invalid uniform bytes length: expected %d bytes, got %d", length, l)
+			if _, err := g.MapUniformToElement(make([]byte, l)); err == nil || err.Error() != expected {
+				t.Fatalf("expected error %q, got %v", expected, err)
+			}
+		}
+	})
+}
+
+func TestHashToGroupState(t *testing.T) {
+	longDST := bytes.Repeat([]byte("a"), 300)
+
+	testAllGroups(t, func(group *testGroup) {
+		g := group.group
+
+		for _, dst := range [][]byte{testHashToGroupDST, group.hashToCurve.dst, longDST} {
+			input := bytes.Repeat(testHashToGroupInput, 50)
+			expected := g.HashToGroup(input, dst)
+
+			// Empty input.
+			state := g.NewHashToGroupState(dst)
+			if state.Sum().Equal(g.HashToGroup(nil, dst)) != 1 {
+				t.Fatal(errExpectedEquality)
+			}
+
+			// Input written in uneven chunks, with intermediate sums not altering the state.
+			for i, size := 0, 1; i < len(input); i, size = i+size, size+7 {
+				end := min(i+size, len(input))
+				if _, err := state.Write(input[i:end]); err != nil {
+					t.Fatal(err)
+				}
+
+				if state.Sum().Equal(g.HashToGroup(input[:end], dst)) != 1 {
+					t.Fatal(errExpectedEquality)
+				}
+			}
+
+			if state.Sum().Equal(expected) != 1 {
+				t.Fatal(errExpectedEquality)
+			}
+
+			// Reset allows reuse.
+			state.Reset()
+			_, _ = state.Write(input)
+
+			if state.Sum().Equal(expected) != 1 {
+				t.Fatal(errExpectedEquality)
+			}
+		}
+
+		// The test vectors.
+		state := g.NewHashToGroupState(group.hashToCurve.dst)
+		_, _ = state.Write(group.hashToCurve.input)
+
+		if state.Sum().Equal(decodeElement(t, g, group.hashToCurve.hashToGroup)) != 1 {
+			t.Fatal(errExpectedEquality)
+		}
+
+		if err := testPanic("nil dst", errZeroLenDST, func() {
+			_ = g.NewHashToGroupState(nil)
+		}); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+func TestHashToScalarFixedContext(t *testing.T) {
+	testAllGroups(t, func(group *testGroup) {
+		g := group.group
+		s := g.HashToScalarFixedContext(testHashToGroupInput, "context")
+
+		if s.Equal(g.HashToScalarFixedContext(testHashToGroupInput, "context")) != 1 {
+			t.Fatal(errExpectedEquality)
+		}
+
+		for _, context := range []string{"", "context2", "Context", strings.Repeat("c", 300)} {
+			if s.Equal(g.HashToScalarFixedContext(testHashToGroupInput, context)) == 1 {
+				t.Fatalf("%q: %s", context, errUnExpectedEquality)
+			}
+		}
+
+		// An empty context doesn't panic, and is distinct from a plain HashToScalar with the context as DST.
+		empty := g.HashToScalarFixedContext(nil, "")
+		if empty.IsZero() {
+			t.Fatal("unexpected zero scalar")
+		}
+
+		if s.Equal(g.HashToScalar(testHashToGroupInput, []byte("context"))) == 1 {
+			t.Fatal(errUnExpectedEquality)
+		}
+	})
+}
+
+func TestGroup_JSON(t *testing.T) {
+	type config struct {
+		Group crypto.Group `json:"group"`
+	}
+
+	testAllGroups(t, func(group *testGroup) {
+		enc, err := json.Marshal(config{Group: group.group})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if string(enc) != fmt.Sprintf(`{"group":%q}`, group.group.String()) {
+			t.Fatalf("unexpected encoding %s", enc)
+		}
+
+		var c config
+		if err = json.Unmarshal(enc, &c); err != nil {
+			t.Fatal(err)
+		}
+
+		if c.Group != group.group {
+			t.Fatalf("expected %v, got %v", group.group, c.Group)
+		}
+	})
+
+	// Unavailable groups
+	for _, id := range []crypto.Group{0, 2, 8} {
+		if _, err := json.Marshal(id); err == nil {
+			t.Fatalf("expected error on group %d", id)
+		}
+	}
+
+	// Unknown identifiers
+	for _, data := range []string{`""`, `"decaf448_XOF:SHAKE256_D448MAP_RO_"`, `"unknown"`, `1`} {
+		var g crypto.Group
+		if err := json.Unmarshal([]byte(data), &g); err == nil {
+			t.Fatalf("expected error on %s", data)
+		}
+	}
+
+	// Malformed JSON strings holding a valid identifier
+	for _, data := range []string{
+		`P256_XMD:SHA-256_SSWU_RO_`,
+		`"P256_XMD:SHA-256_SSWU_RO_`,
+		`"P256_XMD:SHA-256"_SSWU_RO_"`,
+		`""P256_XMD:SHA-256_SSWU_RO_""`,
+		`1`,
+	} {
+		var g crypto.Group
+		if err := g.UnmarshalJSON([]byte(data)); err == nil {
+			t.Fatalf("expected error on %s", data)
+		}
+	}
+}
+
+func TestGroup_Suites(t *testing.T) {
+	suites := map[crypto.Group][2]string{
+		crypto.Ristretto255Sha512: {"ristretto255_XMD:SHA-512_R255MAP_RO_", "ristretto255_XMD:SHA-512_R255MAP_RO_"},
+		crypto.P256Sha256:         {"P256_XMD:SHA-256_SSWU_RO_", "P256_XMD:SHA-256_SSWU_NU_"},
+		crypto.P384Sha384:         {"P384_XMD:SHA-384_SSWU_RO_", "P384_XMD:SHA-384_SSWU_NU_"},
+		crypto.P521Sha512:         {"P521_XMD:SHA-512_SSWU_RO_", "P521_XMD:SHA-512_SSWU_NU_"},
+		crypto.Edwards25519Sha512: {"edwards25519_XMD:SHA-512_ELL2_RO_", "edwards25519_XMD:SHA-512_ELL2_NU_"},
+		crypto.Secp256k1:          {"secp256k1_XMD:SHA-256_SSWU_RO_", "secp256k1_XMD:SHA-256_SSWU_NU_"},
+	}
+
+	testAllGroups(t, func(group *testGroup) {
+		ro, nu := group.group.Suites()
+		if ro != suites[group.group][0] || nu != suites[group.group][1] {
+			t.Fatalf("unexpected suites %q and %q", ro, nu)
+		}
+
+		if ro != group.group.String() || nu != group.group.CiphersuiteNU() {
+			t.Fatal(errExpectedEquality)
+		}
+
+		for _, id := range []string{ro, nu} {
+			if g, err := crypto.GroupFromString(id); err != nil || g != group.group {
+				t.Fatalf("expected %v for %q, got %v (%v)", group.group, id, g, err)
+			}
+		}
+	})
+}
+
+func TestGroup_IdentityEncoding(t *testing.T) {
+	testAllGroups(t, func(group *testGroup) {
+		g := group.group
+		id := g.IdentityEncoding()
+
+		if !bytes.Equal(id, g.NewElement().Encode()) {
+			t.Fatal(errExpectedEquality)
+		}
+
+		if hex.EncodeToString(id) != group.identity {
+			t.Fatalf("unexpected identity encoding %x", id)
+		}
+
+		for i := range id {
+			id[i] ^= 0xff
+		}
+
+		if !bytes.Equal(g.IdentityEncoding(), g.NewElement().Encode()) {
+			t.Fatal("modifying the encoding changed subsequent encodings")
+		}
+	})
+}
@@ -17,6 +17,7 @@ import (
 	"math/big"
 	"os"
 	"path/filepath"
+	"slices"
 	"testing"
 
 	"filippo.io/edwards25519"
@@ -49,6 +50,10 @@ type h2cVector struct {
 		X string `json:"x"`
 		Y string `json:"y"`
 	} `json:"Q1"`
+	Q struct {
+		X string `json:"x"`
+		Y string `json:"y"`
+	} `json:"Q"`
 	Msg string   `json:"msg"`
 	U   []string `json:"u"`
 }
@@ -118,20 +123,66 @@ func vectorToSecp256k1(x, y string) []byte {
 	return output[:]
 }
 
-func (v *h2cVector) run(t *testing.T) {
-	var expected string
-
+// vectorToEncoding returns the hex encoding of the point with the given coordinates, after cofactor clearing if
+// clearCofactor is set.
+func (v *h2cVector) vectorToEncoding(t *testing.T, x, y string, clearCofactor bool) string {
 	switch v.group {
 	case crypto.P256Sha256, crypto.P384Sha384, crypto.P521Sha512:
 		e := ecFromGroup(v.group)
-		x, y := vectorToBig(v.P.X, v.P.Y)
-		expected = hex.EncodeToString(elliptic.MarshalCompressed(e, x, y))
+		xb, yb := vectorToBig(x, y)
+
+		return hex.EncodeToString(elliptic.MarshalCompressed(e, xb, yb))
 	case crypto.Edwards25519Sha512:
-		p := vectorToEdwards25519(t, v.P.X, v.P.Y)
-		expected = hex.EncodeToString(p.Bytes())
+		p := vectorToEdwards25519(t, x, y)
+		if clearCofactor {
+			p.MultByCofactor(p)
+		}
+
+		return hex.EncodeToString(p.Bytes())
 	case crypto.Secp256k1:
-		expected = hex.EncodeToString(vectorToSecp256k1(v.P.X, v.P.Y))
+		return hex.EncodeToString(vectorToSecp256k1(x, y))
+	default:
+		t.Fatal("unexpected group")
+		return ""
 	}
+}
+
+// fieldElementEncoding returns the fixed-length encoding of the field element expected by MapToCurve.
+func (v *h2cVector) fieldElementEncoding(u string) []byte {
+	i, _ := new(big.Int).SetString(u, 0)
+	p, _ := new(big.Int).SetString(v.group.Parameters().FieldOrder, 10)
+
+	b := i.FillBytes(make([]byte, (p.BitLen()+7)/8))
+	if v.group == crypto.Edwards25519Sha512 {
+		slices.Reverse(b)
+	}
+
+	return b
+}
+
+// runMapToCurve verifies MapToCurve against the intermediate map_to_curve outputs of the vector.
+func (v *h2cVector) runMapToCurve(t *testing.T) {
+	q := [][2]string{{v.Q0.X, v.Q0.Y}, {v.Q1.X, v.Q1.Y}}
+	if v.Q.X != "" {
+		q = [][2]string{{v.Q.X, v.Q.Y}}
+	}
+
+	for i, u := range v.U {
+		e, err := v.group.MapToCurve(v.fieldElementEncoding(u))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		expected := v.vectorToEncoding(t, q[i][0], q[i][1], true)
+		if err = verifyEncoding(e, "MapToCurve", expected); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func (v *h2cVector) run(t *testing.T) {
+	expected := v.vectorToEncoding(t, v.P.X, v.P.Y, false)
+	v.runMapToCurve(t)
 
 	switch v.Ciphersuite[len(v.Ciphersuite)-3:] {
 	case "RO_":
@@ -139,6 +190,8 @@ func (v *h2cVector) run(t *testing.T) {
 		if err := verifyEncoding(p, "HashToGroup", expected); err != nil {
 			t.Fatal(err)
 		}
+
+		v.runIntermediate(t, expected)
 	case "NU_":
 		p := v.group.EncodeToGroup([]byte(v.Msg), []byte(v.Dst))
 		if err := verifyEncoding(p, "EncodeToGroup", expected); err != nil {
@@ -149,6 +202,22 @@ func (v *h2cVector) run(t *testing.T) {
 	}
 }
 
+// runIntermediate verifies HashToGroupIntermediate against the output and the Q0 and Q1 values of the vector.
+func (v *h2cVector) runIntermediate(t *testing.T, expected string) {
+	p, q0, q1 := v.group.HashToGroupIntermediate([]byte(v.Msg), []byte(v.Dst))
+	if err := verifyEncoding(p, "HashToGroupIntermediate", expected); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := verifyEncoding(q0, "HashToGroupIntermediate Q0", v.vectorToEncoding(t, v.Q0.X, v.Q0.Y, false)); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := verifyEncoding(q1, "HashToGroupIntermediate Q1", v.vectorToEncoding(t, v.Q1.X, v.Q1.Y, false)); err != nil {
+		t.Fatal(err)
+	}
+}
+
 func verifyEncoding(p *crypto.Element, function, expected string) error {
 	if p.Hex() != expected {
 		return fmt.Errorf("Unexpected %s output.\n\tExpected %q\n\tgot %q",
@@ -224,3 +293,77 @@ func TestHashToGroupVectors(t *testing.T) {
 		t.Fatalf("error opening vector files: %v", err)
 	}
 }
+
+func TestVerifyHashToGroup(t *testing.T) {
+	input := []byte("input")
+	dst := []byte("VerifyHashToGroup-test-DST")
+
+	testAllGroups(t, func(group *testGroup) {
+		g := group.group
+		claimed := g.HashToGroup(input, dst)
+
+		if !g.VerifyHashToGroup(input, dst, claimed) {
+			t.Fatal("expected valid hash-to-group output")
+		}
+
+		if !g.VerifyHashToGroup(input, dst, decodeElement(t, g, claimed.Hex())) {
+			t.Fatal("expected valid hash-to-group output")
+		}
+
+		for i, invalid := range []*crypto.Element{
+			g.HashToGroup([]byte("other input"), dst),
+			g.HashToGroup(input, []byte("another-test-DST")),
+			claimed.Copy().Negate(),
+			g.Base(),
+			g.NewElement(),
+			nil,
+		} {
+			if g.VerifyHashToGroup(input, dst, invalid) {
+				t.Fatalf("%d: expected invalid hash-to-group output", i)
+			}
+		}
+
+		if err := testPanic("zero length DST", errZeroLenDST, func() {
+			_ = g.VerifyHashToGroup(input, nil, claimed)
+		}); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+func TestMapToCurve_Invalid(t *testing.T) {
+	testAllGroups(t, func(group *testGroup) {
+		g := group.group
+		p, _ := new(big.Int).SetString(g.Parameters().FieldOrder, 10)
+		length := (p.BitLen() + 7) / 8
+
+		// The field order, and the largest value of the encoding length, are not field elements.
+		largest := new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), uint(8*length)), big.NewInt(1))
+		for _, i := range []*big.Int{p, largest} {
+			b := i.FillBytes(make([]byte, length))
+			if g == crypto.Ristretto255Sha512 || g == crypto.Edwards25519Sha512 {
+				slices.Reverse(b)
+			}
+
+			if _, err := g.MapToCurve(b); err == nil {
+				t.Fatalf("expected error on %x", b)
+			}
+		}
+
+		// The largest field element is mapped.
+		b := new(big.Int).Sub(p, big.NewInt(1)).FillBytes(make([]byte, length))
+		if g == crypto.Ristretto255Sha512 || g == crypto.Edwards25519Sha512 {
+			slices.Reverse(b)
+		}
+
+		if _, err := g.MapToCurve(b); err != nil {
+			t.Fatal(err)
+		}
+
+		for _, b = range [][]byte{nil, make([]byte, length-1), make([]byte, length+1)} {
+			if _, err := g.MapToCurve(b); err == nil {
+				t.Fatalf("expected error on length %d", len(b))
+			}
+		}
+	})
+}
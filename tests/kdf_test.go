@@ -0,0 +1,166 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (C) 2020-2023 Daniel Bourdrez. All Rights Reserved.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree or at
+// https://spdx.org/licenses/MIT.html
+
+package group_test
+
+import (
+	"bytes"
+	"crypto"
+	"errors"
+	"testing"
+
+	"github.com/bytemare/hash"
+)
+
+func TestDeriveKey(t *testing.T) {
+	info := []byte("key derivation info")
+
+	testAllGroups(t, func(group *testGroup) {
+		g := group.group
+		a, b := g.NewScalar().Random(), g.NewScalar().Random()
+		pubA, pubB := g.Base().Multiply(a), g.Base().Multiply(b)
+
+		// Both sides of an ECDH derive the same key.
+		keyA := g.DeriveKey(pubB.Copy().Multiply(a), info, 32, crypto.SHA256)
+		keyB := g.DeriveKey(pubA.Copy().Multiply(b), info, 32, crypto.SHA256)
+
+		if len(keyA) != 32 {
+			t.Fatalf("expected length 32, got %d", len(keyA))
+		}
+
+		if !bytes.Equal(keyA, keyB) {
+			t.Fatal(errExpectedEquality)
+		}
+
+		// HKDF over the element encoding, without salt.
+		shared := pubB.Copy().Multiply(a)
+		ref := hash.SHA256.GetHashFunction().HKDF(shared.Encode(), nil, info, 32)
+		if !bytes.Equal(keyA, ref) {
+			t.Fatal(errExpectedEquality)
+		}
+
+		// Other info, hash function, or element yield another key.
+		for _, k := range [][]byte{
+			g.DeriveKey(shared, []byte("other info"), 32, crypto.SHA256),
+			g.DeriveKey(shared, info, 32, crypto.SHA512),
+			g.DeriveKey(pubA, info, 32, crypto.SHA256),
+		} {
+			if bytes.Equal(keyA, k) {
+				t.Fatal(errUnExpectedEquality)
+			}
+		}
+
+		// Longer outputs
+		if len(g.DeriveKey(shared, info, 255*64, crypto.SHA512)) != 255*64 {
+			t.Fatal("unexpected output length")
+		}
+	})
+}
+
+func TestDeriveKey_Panics(t *testing.T) {
+	errHKDFHash := errors.New("unsupported or unavailable hash function for HKDF")
+	errHKDFLength := errors.New("invalid HKDF output length")
+
+	testAllGroups(t, func(group *testGroup) {
+		shared := group.group.Base()
+
+		for _, length := range []int{0, -1, 255*32 + 1} {
+			if err := testPanic("invalid length", errHKDFLength, func() {
+				_ = group.group.DeriveKey(shared, nil, length, crypto.SHA256)
+			}); err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		for _, h := range []crypto.Hash{0, crypto.MD4, crypto.Hash(100)} {
+			if err := testPanic("invalid hash", errHKDFHash, func() {
+				_ = group.group.DeriveKey(shared, nil, 32, h)
+			}); err != nil {
+				t.Fatal(err)
+			}
+		}
+	})
+}
+
+func TestScalarFromSeed(t *testing.T) {
+	dst := []byte("ScalarFromSeed-test-DST")
+	seed := []byte("a secret seed with enough entropy")
+
+	testAllGroups(t, func(group *testGroup) {
+		g := group.group
+		s := g.ScalarFromSeed(seed, dst)
+
+		if s.IsZero() {
+			t.Fatal("unexpected zero scalar")
+		}
+
+		// Deterministic, and the expansion of the seed.
+		if s.Equal(g.ScalarFromSeed(seed, dst)) != 1 {
+			t.Fatal(errExpectedEquality)
+		}
+
+		if s.Equal(g.HashToScalar(seed, dst)) != 1 {
+			t.Fatal(errExpectedEquality)
+		}
+
+		// Another seed or DST yields another scalar.
+		if s.Equal(g.ScalarFromSeed([]byte("another secret seed"), dst)) == 1 {
+			t.Fatal(errUnExpectedEquality)
+		}
+
+		if s.Equal(g.ScalarFromSeed(seed, []byte("another-test-DST"))) == 1 {
+			t.Fatal(errUnExpectedEquality)
+		}
+
+		if err := testPanic("zero length DST", errors.New("zero-length DST"), func() {
+			_ = g.ScalarFromSeed(seed, nil)
+		}); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+func TestDeriveScalar(t *testing.T) {
+	seed := []byte("a secret seed with enough entropy")
+	context := []byte("context")
+
+	testAllGroups(t, func(group *testGroup) {
+		g := group.group
+		s := g.DeriveScalar(seed, context)
+
+		if s.IsZero() {
+			t.Fatal("unexpected zero scalar")
+		}
+
+		// Deterministic, and distinct from hashing the seed.
+		if s.Equal(g.DeriveScalar(seed, context)) != 1 {
+			t.Fatal(errExpectedEquality)
+		}
+
+		if s.Equal(g.HashToScalar(seed, context)) == 1 {
+			t.Fatal(errUnExpectedEquality)
+		}
+
+		// Another seed or context yields another scalar, including when shifting bytes between them.
+		for _, in := range [][2][]byte{
+			{[]byte("another secret seed"), context},
+			{seed, []byte("another context")},
+			{seed, nil},
+			{append(context[len(context)-1:], seed...), context[:len(context)-1]},
+		} {
+			if s.Equal(g.DeriveScalar(in[0], in[1])) == 1 {
+				t.Fatal(errUnExpectedEquality)
+			}
+		}
+
+		// Empty inputs are accepted.
+		if g.DeriveScalar(nil, nil).IsZero() {
+			t.Fatal("unexpected zero scalar")
+		}
+	})
+}
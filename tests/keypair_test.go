@@ -0,0 +1,239 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (C) 2020-2023 Daniel Bourdrez. All Rights Reserved.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree or at
+// https://spdx.org/licenses/MIT.html
+
+package group_test
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/hex"
+	"errors"
+	"testing"
+
+	"github.com/bytemare/crypto"
+)
+
+func isPKCS8Group(g crypto.Group) bool {
+	switch g {
+	case crypto.P256Sha256, crypto.P384Sha384, crypto.P521Sha512:
+		return true
+	default:
+		return false
+	}
+}
+
+func pkcs8Curve(g crypto.Group) elliptic.Curve {
+	switch g {
+	case crypto.P256Sha256:
+		return elliptic.P256()
+	case crypto.P384Sha384:
+		return elliptic.P384()
+	default:
+		return elliptic.P521()
+	}
+}
+
+func newKeypair(g crypto.Group) *crypto.Keypair {
+	secret := g.NewScalar().Random()
+	return &crypto.Keypair{Secret: secret, Public: g.Base().Multiply(secret)}
+}
+
+func TestKeypair_PKCS8(t *testing.T) {
+	testAllGroups(t, func(group *testGroup) {
+		g := group.group
+		if !isPKCS8Group(g) {
+			return
+		}
+
+		kp := newKeypair(g)
+
+		der, err := kp.MarshalPKCS8(g)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// Go's x509 parser recovers the same key.
+		key, err := x509.ParsePKCS8PrivateKey(der)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		ecKey, ok := key.(*ecdsa.PrivateKey)
+		if !ok {
+			t.Fatalf("unexpected key type %T", key)
+		}
+
+		if ecKey.Curve != pkcs8Curve(g) {
+			t.Fatal("unexpected curve")
+		}
+
+		d := hex.EncodeToString(ecKey.D.FillBytes(make([]byte, group.scalarLength)))
+		if decodeScalar(t, g, d).Equal(kp.Secret) != 1 {
+			t.Fatal(errExpectedEquality)
+		}
+
+		// Round-trip.
+		parsed, err := g.ParsePKCS8(der)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if parsed.Secret.Equal(kp.Secret) != 1 || parsed.Public.Equal(kp.Public) != 1 {
+			t.Fatal(errExpectedEquality)
+		}
+
+		// Keys generated by the standard library are parsed.
+		stdKey, err := ecdsa.GenerateKey(pkcs8Curve(g), rand.Reader)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		der, err = x509.MarshalPKCS8PrivateKey(stdKey)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if parsed, err = g.ParsePKCS8(der); err != nil {
+			t.Fatal(err)
+		}
+
+		pub := elliptic.MarshalCompressed(stdKey.Curve, stdKey.X, stdKey.Y)
+		if parsed.Public.Equal(decodeElement(t, g, hex.EncodeToString(pub))) != 1 {
+			t.Fatal(errExpectedEquality)
+		}
+	})
+}
+
+func TestKeypair_PKCS8_Errors(t *testing.T) {
+	errUnsupported := errors.New("operation not supported by this group")
+
+	testAllGroups(t, func(group *testGroup) {
+		g := group.group
+		if !isPKCS8Group(g) {
+			expected := "marshal PKCS8: " + errUnsupported.Error()
+			if _, err := newKeypair(g).MarshalPKCS8(g); err == nil || err.Error() != expected {
+				t.Fatalf("expected error %q, got %v", expected, err)
+			}
+
+			expected = "parse PKCS8: " + errUnsupported.Error()
+			if _, err := g.ParsePKCS8(nil); err == nil || err.Error() != expected {
+				t.Fatalf("expected error %q, got %v", expected, err)
+			}
+
+			return
+		}
+
+		// Mismatching or missing keys
+		kp := newKeypair(g)
+		for _, bad := range []*crypto.Keypair{
+			{Secret: kp.Secret, Public: g.Base()},
+			{Secret: kp.Secret},
+			{Public: kp.Public},
+			{Secret: g.NewScalar(), Public: g.NewElement()},
+		} {
+			if _, err := bad.MarshalPKCS8(g); err == nil {
+				t.Fatal("expected error on invalid key pair")
+			}
+		}
+
+		// Invalid DER
+		if _, err := g.ParsePKCS8([]byte{0x30, 0x00}); err == nil {
+			t.Fatal("expected error on invalid DER")
+		}
+
+		// A key of another curve
+		other := crypto.P256Sha256
+		if g == crypto.P256Sha256 {
+			other = crypto.P384Sha384
+		}
+
+		der, err := newKeypair(other).MarshalPKCS8(other)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if _, err = g.ParsePKCS8(der); err == nil {
+			t.Fatal("expected error on key of another curve")
+		}
+
+		// A key of another type
+		_, edKey, err := ed25519.GenerateKey(rand.Reader)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		der, err = x509.MarshalPKCS8PrivateKey(edKey)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if _, err = g.ParsePKCS8(der); err == nil {
+			t.Fatal("expected error on non-EC key")
+		}
+	})
+}
+
+func TestKeypair_Encode(t *testing.T) {
+	testAllGroups(t, func(group *testGroup) {
+		g := group.group
+		kp := newKeypair(g)
+		enc := kp.Encode()
+
+		if len(enc) != g.ScalarLength()+g.ElementLength() {
+			t.Fatalf("unexpected encoding length %d", len(enc))
+		}
+
+		decoded, err := g.DecodeKeypair(enc)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if decoded.Secret.Equal(kp.Secret) != 1 || decoded.Public.Equal(kp.Public) != 1 {
+			t.Fatal(errExpectedEquality)
+		}
+	})
+}
+
+func TestKeypair_Decode_Errors(t *testing.T) {
+	errMismatch := errors.New("decode keypair: public key does not match the secret key")
+	errLength := errors.New("decode keypair: invalid key pair encoding length")
+
+	testAllGroups(t, func(group *testGroup) {
+		g := group.group
+		kp := newKeypair(g)
+		enc := kp.Encode()
+
+		// Wrong lengths
+		for _, bad := range [][]byte{nil, enc[:len(enc)-1], append(enc, 0)} {
+			if _, err := g.DecodeKeypair(bad); err == nil || err.Error() != errLength.Error() {
+				t.Fatalf("expected error %q, got %v", errLength, err)
+			}
+		}
+
+		// A public key of another secret
+		other := (&crypto.Keypair{Secret: kp.Secret, Public: g.Base()}).Encode()
+		if _, err := g.DecodeKeypair(other); err == nil || err.Error() != errMismatch.Error() {
+			t.Fatalf("expected error %q, got %v", errMismatch, err)
+		}
+
+		// Invalid scalar and element encodings
+		badScalar := append(bytes.Repeat([]byte{0xff}, g.ScalarLength()), kp.Public.Encode()...)
+		if _, err := g.DecodeKeypair(badScalar); err == nil {
+			t.Fatal("expected error on invalid scalar")
+		}
+
+		badElement := append(kp.Secret.Encode(), make([]byte, g.ElementLength())...)
+		if _, err := g.DecodeKeypair(badElement); err == nil {
+			t.Fatal("expected error on invalid element")
+		}
+	})
+}
@@ -0,0 +1,137 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (C) 2020-2023 Daniel Bourdrez. All Rights Reserved.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree or at
+// https://spdx.org/licenses/MIT.html
+
+package group_test
+
+import (
+	"bytes"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/bytemare/crypto"
+)
+
+func isMontgomeryGroup(g crypto.Group) bool {
+	switch g {
+	case crypto.P256Sha256, crypto.P384Sha384, crypto.P521Sha512, crypto.Secp256k1:
+		return true
+	default:
+		return false
+	}
+}
+
+// montgomeryMultiply is a reference implementation of the Montgomery product a * b * R^-1 mod n.
+func montgomeryMultiply(t *testing.T, g crypto.Group, a, b []byte) []byte {
+	n, ok := new(big.Int).SetString(g.Order(), 0)
+	if !ok {
+		t.Fatal("could not parse order")
+	}
+
+	words := (n.BitLen() + 63) / 64
+	r := new(big.Int).Lsh(big.NewInt(1), uint(words*64))
+	rInv := new(big.Int).ModInverse(r, n)
+
+	res := new(big.Int).Mul(new(big.Int).SetBytes(a), new(big.Int).SetBytes(b))
+	res.Mul(res, rInv)
+	res.Mod(res, n)
+
+	return res.FillBytes(make([]byte, g.ScalarLength()))
+}
+
+func TestScalar_Montgomery_RoundTrip(t *testing.T) {
+	testAllGroups(t, func(group *testGroup) {
+		if !isMontgomeryGroup(group.group) {
+			return
+		}
+
+		for _, s := range []*crypto.Scalar{
+			group.group.NewScalar(),
+			group.group.NewScalar().One(),
+			group.group.NewScalar().Random(),
+			group.group.NewScalar().Subtract(group.group.NewScalar().One()),
+		} {
+			m := group.group.ScalarToMontgomery(s)
+			if len(m) != group.scalarLength {
+				t.Fatalf("expected length %d, got %d", group.scalarLength, len(m))
+			}
+
+			d, err := group.group.ScalarFromMontgomery(m)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if d.Equal(s) != 1 {
+				t.Fatal(errExpectedEquality)
+			}
+		}
+	})
+}
+
+func TestScalar_Montgomery_Multiplication(t *testing.T) {
+	testAllGroups(t, func(group *testGroup) {
+		if !isMontgomeryGroup(group.group) {
+			return
+		}
+
+		a := group.group.NewScalar().Random()
+		b := group.group.NewScalar().Random()
+		ab := a.Copy().Multiply(b)
+
+		am := group.group.ScalarToMontgomery(a)
+		bm := group.group.ScalarToMontgomery(b)
+		abm := montgomeryMultiply(t, group.group, am, bm)
+
+		if !bytes.Equal(abm, group.group.ScalarToMontgomery(ab)) {
+			t.Fatal(errExpectedEquality)
+		}
+
+		res, err := group.group.ScalarFromMontgomery(abm)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if res.Equal(ab) != 1 {
+			t.Fatal(errExpectedEquality)
+		}
+	})
+}
+
+func TestScalar_Montgomery_Errors(t *testing.T) {
+	errUnsupported := errors.New("operation not supported by this group")
+
+	testAllGroups(t, func(group *testGroup) {
+		if !isMontgomeryGroup(group.group) {
+			if err := testPanic("unsupported group", errUnsupported, func() {
+				_ = group.group.ScalarToMontgomery(group.group.NewScalar())
+			}); err != nil {
+				t.Fatal(err)
+			}
+
+			expected := "montgomery: " + errUnsupported.Error()
+			if _, err := group.group.ScalarFromMontgomery(nil); err == nil || err.Error() != expected {
+				t.Fatalf("expected error %q, got %v", expected, err)
+			}
+
+			return
+		}
+
+		if _, err := group.group.ScalarFromMontgomery(nil); err == nil {
+			t.Fatal("expected error on nil input")
+		}
+
+		if _, err := group.group.ScalarFromMontgomery(make([]byte, group.scalarLength-1)); err == nil {
+			t.Fatal("expected error on short input")
+		}
+
+		order, _ := new(big.Int).SetString(group.group.Order(), 0)
+		if _, err := group.group.ScalarFromMontgomery(order.FillBytes(make([]byte, group.scalarLength))); err == nil {
+			t.Fatal("expected error on input higher than the order")
+		}
+	})
+}
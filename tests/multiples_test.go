@@ -0,0 +1,173 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (C) 2020-2023 Daniel Bourdrez. All Rights Reserved.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree or at
+// https://spdx.org/licenses/MIT.html
+
+package group_test
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/bytemare/crypto"
+)
+
+func TestBaseMultiplesEncoded(t *testing.T) {
+	testAllGroups(t, func(group *testGroup) {
+		g := group.group
+		n := 20
+		table := g.BaseMultiplesEncoded(n)
+
+		if len(table) != n {
+			t.Fatalf("expected %d entries, got %d", n, len(table))
+		}
+
+		if !bytes.Equal(table[0], g.NewElement().Encode()) {
+			t.Fatal("expected the identity encoding as first entry")
+		}
+
+		for i := 1; i < n; i++ {
+			e := g.NewElement()
+			if err := e.Decode(table[i]); err != nil {
+				t.Fatal(err)
+			}
+
+			if e.Equal(g.Base().Multiply(g.NewScalar().SetUInt64(uint64(i)))) != 1 {
+				t.Fatalf("%d: %s", i, errExpectedEquality)
+			}
+
+			if i <= len(group.multBase) && hex.EncodeToString(table[i]) != group.multBase[i-1] {
+				t.Fatalf("%d: %s", i, errExpectedEquality)
+			}
+		}
+
+		for _, n := range []int{0, -1} {
+			if table = g.BaseMultiplesEncoded(n); table == nil || len(table) != 0 {
+				t.Fatalf("expected empty table for n = %d", n)
+			}
+		}
+	})
+}
+
+func TestPowersOf(t *testing.T) {
+	testAllGroups(t, func(group *testGroup) {
+		g := group.group
+		x := g.NewScalar().Random()
+		powers := g.PowersOf(x, 10)
+
+		if len(powers) != 10 {
+			t.Fatalf("expected 10 powers, got %d", len(powers))
+		}
+
+		for i, p := range powers {
+			if p.Equal(x.Copy().Pow(g.NewScalar().SetUInt64(uint64(i)))) != 1 {
+				t.Fatalf("%d: %s", i, errExpectedEquality)
+			}
+		}
+
+		// The input is not modified.
+		if powers[1].Equal(x) != 1 || powers[1] == x {
+			t.Fatal("expected a copy of x")
+		}
+
+		// Zero and nil yield 1, 0, 0, ...
+		for _, z := range []*crypto.Scalar{g.NewScalar(), nil} {
+			powers = g.PowersOf(z, 3)
+			if !powers[0].IsOne() || !powers[1].IsZero() || !powers[2].IsZero() {
+				t.Fatal("unexpected powers of 0")
+			}
+		}
+
+		for _, n := range []int{0, -1} {
+			if powers = g.PowersOf(x, n); powers == nil || len(powers) != 0 {
+				t.Fatalf("expected an empty slice for n = %d", n)
+			}
+		}
+	})
+}
+
+func TestElement_SelectFrom(t *testing.T) {
+	testAllGroups(t, func(group *testGroup) {
+		g := group.group
+		table := []*crypto.Element{g.Base(), g.Base().Double(), nil, g.RandomElement()}
+
+		for i, entry := range table {
+			if entry == nil {
+				entry = g.NewElement()
+			}
+
+			if g.RandomElement().SelectFrom(table, i).Equal(entry) != 1 {
+				t.Fatalf("%d: %s", i, errExpectedEquality)
+			}
+		}
+
+		// Out of range indexes and empty tables yield the identity element, including indexes whose lower 32 bits are
+		// those of a valid index.
+		indexes := []int{-1, len(table), 1 << 20}
+		if strconv.IntSize == 64 {
+			shift := 32
+			indexes = append(indexes, 1<<shift, 1<<shift+1, -(1 << shift))
+		}
+
+		for _, i := range indexes {
+			if !g.Base().SelectFrom(table, i).IsIdentity() {
+				t.Fatalf("%d: expected the identity element", i)
+			}
+		}
+
+		if !g.Base().SelectFrom(nil, 0).IsIdentity() {
+			t.Fatal("expected the identity element")
+		}
+	})
+}
+
+func TestGroup_PrecomputeTable(t *testing.T) {
+	testAllGroups(t, func(group *testGroup) {
+		g := group.group
+		base := g.RandomElement()
+		scalars := []*crypto.Scalar{
+			g.NewScalar().Random(),
+			g.NewScalar().One(),
+			g.NewScalar().Zero(),
+			g.NewScalar().One().Negate(),
+		}
+
+		for _, w := range []int{1, 3, 4, 5} {
+			table := g.PrecomputeTable(base, w)
+
+			for _, s := range scalars {
+				if table.Multiply(s).Equal(base.Copy().Multiply(s)) != 1 {
+					t.Fatalf("window %d: %s", w, errExpectedEquality)
+				}
+			}
+
+			if !table.Multiply(nil).IsIdentity() {
+				t.Fatal("expected the identity element")
+			}
+		}
+
+		if !g.PrecomputeTable(nil, 4).Multiply(scalars[0]).IsIdentity() {
+			t.Fatal("expected the identity element")
+		}
+
+		// The base is not modified.
+		if base.Equal(g.PrecomputeTable(base, 2).Multiply(scalars[0])) == 1 {
+			t.Fatal(errUnExpectedEquality)
+		}
+
+		errWindow := errors.New("window must be between 1 and 8")
+		for _, w := range []int{0, -1, 9} {
+			if err := testPanic("PrecomputeTable", errWindow, func() {
+				_ = g.PrecomputeTable(base, w)
+			}); err != nil {
+				t.Fatal(err)
+			}
+		}
+	})
+}
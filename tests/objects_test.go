@@ -0,0 +1,111 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (C) 2020-2023 Daniel Bourdrez. All Rights Reserved.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree or at
+// https://spdx.org/licenses/MIT.html
+
+package group_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/bytemare/crypto"
+)
+
+func TestMarshalObjects(t *testing.T) {
+	testAllGroups(t, func(group *testGroup) {
+		g := group.group
+
+		for _, counts := range [][2]int{{0, 0}, {3, 0}, {0, 3}, {1, 1}, {2, 5}} {
+			scalars := randomScalars(g, counts[0])
+			elements := randomElements(g, counts[1])
+			encoded := g.MarshalObjects(scalars, elements)
+
+			if len(encoded) != 9+counts[0]*group.scalarLength+counts[1]*group.elementLength {
+				t.Fatalf("unexpected length %d", len(encoded))
+			}
+
+			if encoded[0] != byte(g) {
+				t.Fatalf("unexpected group identifier %d", encoded[0])
+			}
+
+			s, e, err := g.UnmarshalObjects(encoded)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if len(s) != counts[0] || len(e) != counts[1] {
+				t.Fatalf("unexpected counts %d and %d", len(s), len(e))
+			}
+
+			for i := range s {
+				if s[i].Equal(scalars[i]) != 1 {
+					t.Fatal(errExpectedEquality)
+				}
+			}
+
+			for i := range e {
+				if e[i].Equal(elements[i]) != 1 {
+					t.Fatal(errExpectedEquality)
+				}
+			}
+		}
+	})
+}
+
+func TestUnmarshalObjects_Errors(t *testing.T) {
+	errObjGroup := errors.New("unmarshal objects: objects are encoded for another group")
+	errObjLength := errors.New("unmarshal objects: invalid length of encoded objects")
+
+	expectError := func(t *testing.T, g crypto.Group, data []byte, expected error) {
+		t.Helper()
+
+		if _, _, err := g.UnmarshalObjects(data); err == nil ||
+			(expected != nil && err.Error() != expected.Error()) {
+			t.Fatalf("expected error %v, got %v", expected, err)
+		}
+	}
+
+	testAllGroups(t, func(group *testGroup) {
+		g := group.group
+		encoded := g.MarshalObjects(randomScalars(g, 2), randomElements(g, 2))
+
+		// Truncated header or objects, and trailing data
+		expectError(t, g, nil, errObjLength)
+		expectError(t, g, encoded[:8], errObjLength)
+		expectError(t, g, encoded[:len(encoded)-1], errObjLength)
+		expectError(t, g, append(slices.Clone(encoded), 0x00), errObjLength)
+
+		// Another group
+		other := crypto.Ristretto255Sha512
+		if g == other {
+			other = crypto.P256Sha256
+		}
+
+		expectError(t, g, other.MarshalObjects(nil, nil), errObjGroup)
+
+		// Tampered counts
+		for _, counts := range [][2]uint32{{3, 2}, {2, 1}, {1, 2}, {0xffffffff, 2}} {
+			tampered := slices.Clone(encoded)
+			binary.BigEndian.PutUint32(tampered[1:5], counts[0])
+			binary.BigEndian.PutUint32(tampered[5:9], counts[1])
+			expectError(t, g, tampered, errObjLength)
+		}
+
+		// Invalid scalar
+		tampered := slices.Clone(encoded)
+		copy(tampered[9:], bytes.Repeat([]byte{0xff}, group.scalarLength))
+		expectError(t, g, tampered, nil)
+
+		// Identity element
+		tampered = slices.Clone(encoded)
+		copy(tampered[9+2*group.scalarLength:], g.NewElement().Encode())
+		expectError(t, g, tampered, nil)
+	})
+}
@@ -0,0 +1,87 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (C) 2020-2023 Daniel Bourdrez. All Rights Reserved.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree or at
+// https://spdx.org/licenses/MIT.html
+
+package group_test
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/bytemare/crypto"
+)
+
+var testParameters = map[crypto.Group]struct {
+	order         string
+	cofactor      uint
+	securityLevel uint
+}{
+	crypto.Ristretto255Sha512: {
+		"7237005577332262213973186563042994240857116359379907606001950938285454250989", 1, 128,
+	},
+	crypto.P256Sha256: {
+		"115792089210356248762697446949407573529996955224135760342422259061068512044369", 1, 128,
+	},
+	crypto.P384Sha384: {
+		"39402006196394479212279040100143613805079739270465446667946905279627659399113263569398956308152294913554433653942643",
+		1,
+		192,
+	},
+	crypto.P521Sha512: {
+		"6864797660130609714981900799081393217269435300143305409394463459185543183397655394245057746333217197532963996371363321113864768612440380340372808892707005449",
+		1,
+		256,
+	},
+	crypto.Edwards25519Sha512: {
+		"7237005577332262213973186563042994240857116359379907606001950938285454250989", 8, 128,
+	},
+	crypto.Secp256k1: {
+		"115792089237316195423570985008687907852837564279074904382605163141518161494337", 1, 128,
+	},
+}
+
+func TestGroup_Parameters(t *testing.T) {
+	testAllGroups(t, func(group *testGroup) {
+		p := group.group.Parameters()
+		ref, ok := testParameters[group.group]
+		if !ok {
+			t.Fatal("missing reference parameters")
+		}
+
+		if p.FieldOrder != group.fieldOrder {
+			t.Fatalf("expected field order %s, got %s", group.fieldOrder, p.FieldOrder)
+		}
+
+		if p.Order != ref.order {
+			t.Fatalf("expected order %s, got %s", ref.order, p.Order)
+		}
+
+		if p.Cofactor != ref.cofactor {
+			t.Fatalf("expected cofactor %d, got %d", ref.cofactor, p.Cofactor)
+		}
+
+		if p.SecurityLevel != ref.securityLevel {
+			t.Fatalf("expected security level %d, got %d", ref.securityLevel, p.SecurityLevel)
+		}
+
+		if hex.EncodeToString(p.Generator) != group.basePoint {
+			t.Fatalf("expected generator %s, got %x", group.basePoint, p.Generator)
+		}
+
+		if p.HashToGroup != group.h2c {
+			t.Fatalf("expected ciphersuite %s, got %s", group.h2c, p.HashToGroup)
+		}
+
+		if p.EncodeToGroup != group.e2c {
+			t.Fatalf("expected ciphersuite %s, got %s", group.e2c, p.EncodeToGroup)
+		}
+
+		if p.Hash != group.hash {
+			t.Fatalf("expected hash %v, got %v", group.hash, p.Hash)
+		}
+	})
+}
@@ -0,0 +1,27 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (C) 2020-2023 Daniel Bourdrez. All Rights Reserved.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree or at
+// https://spdx.org/licenses/MIT.html
+
+package group_test
+
+import (
+	"testing"
+)
+
+func TestGroup_BenchmarkProfile(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping profiling in short mode")
+	}
+
+	testAllGroups(t, func(group *testGroup) {
+		p := group.group.BenchmarkProfile()
+
+		if p.ScalarBaseMult <= 0 || p.ScalarMult <= 0 || p.HashToGroup <= 0 || p.Add <= 0 {
+			t.Fatalf("expected positive timings, got %+v", p)
+		}
+	})
+}
@@ -12,8 +12,12 @@ import (
 	"bytes"
 	"crypto"
 	"encoding/hex"
+	"slices"
 	"testing"
 
+	"github.com/gtank/ristretto255"
+
+	group "github.com/bytemare/crypto"
 	"github.com/bytemare/crypto/internal/ristretto"
 )
 
@@ -182,3 +186,30 @@ func TestRistrettoElement(t *testing.T) {
 		})
 	}
 }
+
+func TestRistretto_MapToCurve(t *testing.T) {
+	g := group.Ristretto255Sha512
+
+	if e, err := g.MapToCurve(make([]byte, 32)); err != nil || !e.IsIdentity() {
+		t.Fatal("expected identity")
+	}
+
+	// The one-way map is the sum of the maps of both halves of its input.
+	a, b := g.NewScalar().Random().Encode(), g.NewScalar().Random().Encode()
+	uniform := append(slices.Clone(a), b...)
+	expected := ristretto255.NewElement().FromUniformBytes(uniform).Encode(nil)
+
+	ea, err := g.MapToCurve(a)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	eb, err := g.MapToCurve(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !bytes.Equal(ea.Add(eb).Encode(), expected) {
+		t.Fatal(errExpectedEquality)
+	}
+}
@@ -197,6 +197,565 @@ func TestScalar_SetUInt64(t *testing.T) {
 	})
 }
 
+func TestScalar_SetBigInt(t *testing.T) {
+	testAllGroups(t, func(group *testGroup) {
+		g := group.group
+		order := g.OrderBigInt()
+
+		// Small values match SetUInt64.
+		for _, v := range []uint64{0, 1, 2, 255, 1 << 32, math.MaxUint64} {
+			if g.NewScalar().SetBigInt(new(big.Int).SetUint64(v)).Equal(g.NewScalar().SetUInt64(v)) != 1 {
+				t.Fatalf("%d: %s", v, errExpectedEquality)
+			}
+		}
+
+		// Large and negative values are reduced modulo the order.
+		large := new(big.Int).Lsh(big.NewInt(1), 3*uint(order.BitLen()))
+		large.Add(large, big.NewInt(12345))
+
+		values := []*big.Int{large, new(big.Int).Neg(large), order, new(big.Int).Add(order, big.NewInt(7)), big.NewInt(-1)}
+		for _, v := range values {
+			expected := new(big.Int).Mod(v, order)
+			if g.NewScalar().SetBigInt(v).DebugString() != expected.String() {
+				t.Fatalf("%s: %s", v, errExpectedEquality)
+			}
+		}
+
+		// The input is not modified, and nil is treated as 0.
+		if large.Cmp(new(big.Int).Add(new(big.Int).Lsh(big.NewInt(1), 3*uint(order.BitLen())), big.NewInt(12345))) != 0 {
+			t.Fatal("unexpected modification of the input")
+		}
+
+		if !g.NewScalar().Random().SetBigInt(nil).IsZero() {
+			t.Fatal("expected 0")
+		}
+	})
+}
+
+func TestScalar_ScalarFromInt64(t *testing.T) {
+	testAllGroups(t, func(group *testGroup) {
+		// -1 is order - 1
+		maxScalar := group.group.NewScalar().Subtract(group.group.NewScalar().One())
+		if group.group.ScalarFromInt64(-1).Equal(maxScalar) != 1 {
+			t.Fatal(errExpectedEquality)
+		}
+
+		// Positive values match SetUInt64
+		for _, v := range []int64{0, 1, 2, 255, 1 << 32, math.MaxInt64} {
+			if group.group.ScalarFromInt64(v).Equal(group.group.NewScalar().SetUInt64(uint64(v))) != 1 {
+				t.Fatalf("%d: %s", v, errExpectedEquality)
+			}
+		}
+
+		// Negative values are additive inverses of their absolute value
+		for _, v := range []int64{-2, -255, -(1 << 32), math.MinInt64 + 1} {
+			s := group.group.ScalarFromInt64(v).Add(group.group.NewScalar().SetUInt64(uint64(-v)))
+			if !s.IsZero() {
+				t.Fatalf("%d: expected zero", v)
+			}
+		}
+
+		// math.MinInt64
+		s := group.group.ScalarFromInt64(math.MinInt64).Add(group.group.NewScalar().SetUInt64(1 << 63))
+		if !s.IsZero() {
+			t.Fatal("expected zero")
+		}
+	})
+}
+
+func TestScalar_ScalarToInt64(t *testing.T) {
+	values := []int64{0, 1, -1, 2, -2, 255, -255, 1 << 32, -(1 << 32), math.MaxInt64, math.MinInt64, math.MinInt64 + 1}
+
+	testAllGroups(t, func(group *testGroup) {
+		for _, v := range values {
+			i, err := group.group.ScalarToInt64(group.group.ScalarFromInt64(v))
+			if err != nil {
+				t.Fatalf("%d: unexpected error %v", v, err)
+			}
+
+			if i != v {
+				t.Fatalf("expected %d, got %d", v, i)
+			}
+		}
+
+		// Out of range scalars
+		outOfRange := []*crypto.Scalar{
+			group.group.NewScalar().SetUInt64(math.MaxInt64 + 1),
+			group.group.NewScalar().SetUInt64(math.MaxUint64),
+			group.group.ScalarFromInt64(math.MinInt64).Subtract(group.group.NewScalar().One()),
+			group.group.NewScalar().SetUInt64(math.MaxUint64).Multiply(group.group.NewScalar().SetUInt64(math.MaxUint64)),
+		}
+
+		for _, s := range outOfRange {
+			if _, err := group.group.ScalarToInt64(s); err == nil {
+				t.Fatalf("expected error for %s", s.Hex())
+			}
+		}
+	})
+}
+
+func TestScalar_IsInvertible(t *testing.T) {
+	testAllGroups(t, func(group *testGroup) {
+		if group.group.NewScalar().IsInvertible() {
+			t.Fatal("expected zero to not be invertible")
+		}
+
+		for _, s := range []*crypto.Scalar{
+			group.group.NewScalar().One(),
+			group.group.NewScalar().Random(),
+			group.group.ScalarFromInt64(-1),
+		} {
+			if !s.IsInvertible() {
+				t.Fatal("expected non-zero scalar to be invertible")
+			}
+
+			if s.Copy().Invert().Invert().Equal(s) != 1 {
+				t.Fatal(errExpectedEquality)
+			}
+
+			if s.Copy().Invert().Multiply(s).Equal(group.group.NewScalar().One()) != 1 {
+				t.Fatal(errExpectedEquality)
+			}
+		}
+	})
+}
+
+func TestScalar_Invert_Zero(t *testing.T) {
+	testAllGroups(t, func(group *testGroup) {
+		zero := group.group.NewScalar()
+		if !zero.Invert().IsZero() {
+			t.Fatal("expected the inverse of zero to be zero")
+		}
+
+		// The same holds for a zero obtained by arithmetic.
+		s := group.group.NewScalar().One().Subtract(group.group.NewScalar().One())
+		if !s.Invert().IsZero() {
+			t.Fatal("expected the inverse of zero to be zero")
+		}
+	})
+}
+
+func TestScalar_Encode_FreshSlice(t *testing.T) {
+	testAllGroups(t, func(group *testGroup) {
+		for _, s := range []*crypto.Scalar{
+			group.group.NewScalar(),
+			group.group.NewScalar().One(),
+			group.group.NewScalar().Random(),
+		} {
+			reference := s.Copy()
+			enc := s.Encode()
+			expected := bytes.Clone(enc)
+
+			for i := range enc {
+				enc[i] ^= 0xff
+			}
+
+			if !bytes.Equal(s.Encode(), expected) {
+				t.Fatal("modifying the encoding changed subsequent encodings")
+			}
+
+			if s.Equal(reference) != 1 {
+				t.Fatal("modifying the encoding changed the scalar")
+			}
+
+			// Two encodings never share memory.
+			a, b := s.Encode(), s.Encode()
+			a[0] ^= 0xff
+
+			if bytes.Equal(a, b) {
+				t.Fatal(errUnExpectedEquality)
+			}
+		}
+	})
+}
+
+func TestScalar_IsOne(t *testing.T) {
+	testAllGroups(t, func(group *testGroup) {
+		g := group.group
+
+		if !g.NewScalar().One().IsOne() {
+			t.Fatal("expected one")
+		}
+
+		if !g.NewScalar().SetUInt64(2).Subtract(g.NewScalar().One()).IsOne() {
+			t.Fatal("expected one")
+		}
+
+		for _, s := range []*crypto.Scalar{
+			g.NewScalar(),
+			g.NewScalar().SetUInt64(2),
+			g.NewScalar().Random(),
+			g.ScalarFromInt64(-1),
+		} {
+			if s.IsOne() {
+				t.Fatalf("unexpected one for %s", s.Hex())
+			}
+		}
+	})
+}
+
+func TestScalar_Negate(t *testing.T) {
+	testAllGroups(t, func(group *testGroup) {
+		g := group.group
+
+		for _, s := range append(randomScalars(g, 10), g.NewScalar().One(), g.ScalarFromInt64(-1)) {
+			n := s.Copy()
+			if n.Negate() != n {
+				t.Fatal("expected the receiver to be returned")
+			}
+
+			if !n.Copy().Add(s).IsZero() {
+				t.Fatal("expected zero")
+			}
+
+			if n.Equal(g.NewScalar().Subtract(s)) != 1 || n.Negate().Equal(s) != 1 {
+				t.Fatal(errExpectedEquality)
+			}
+		}
+
+		if !g.NewScalar().Negate().IsZero() {
+			t.Fatal("expected zero")
+		}
+
+		if g.NewScalar().One().Negate().Equal(g.ScalarFromInt64(-1)) != 1 {
+			t.Fatal(errExpectedEquality)
+		}
+	})
+}
+
+func TestScalar_Double(t *testing.T) {
+	testAllGroups(t, func(group *testGroup) {
+		g := group.group
+		minusOne := g.ScalarFromInt64(-1)
+
+		for _, s := range []*crypto.Scalar{
+			g.NewScalar(),
+			g.NewScalar().One(),
+			g.NewScalar().Random(),
+			minusOne,
+		} {
+			expected := s.Copy().Multiply(g.NewScalar().SetUInt64(2))
+
+			d := s.Copy()
+			if d.Double() != d {
+				t.Fatal("expected the receiver to be returned")
+			}
+
+			if d.Equal(expected) != 1 || d.Equal(s.Copy().Add(s)) != 1 {
+				t.Fatal(errExpectedEquality)
+			}
+		}
+
+		// 2 * (-1) = -2 wraps around the order.
+		if minusOne.Double().Equal(g.ScalarFromInt64(-2)) != 1 {
+			t.Fatal(errExpectedEquality)
+		}
+	})
+}
+
+func TestScalar_CmpCT(t *testing.T) {
+	testAllGroups(t, func(group *testGroup) {
+		g := group.group
+		toInt := func(s *crypto.Scalar) *big.Int {
+			i, _ := new(big.Int).SetString(s.DebugString(), 10)
+			return i
+		}
+
+		scalars := []*crypto.Scalar{
+			g.NewScalar(),
+			g.NewScalar().One(),
+			g.NewScalar().SetUInt64(2),
+			g.NewScalar().SetUInt64(0xff),
+			g.NewScalar().SetUInt64(0x100),
+			g.NewScalar().SetUInt64(0x1ff),
+			g.ScalarFromInt64(-2),
+			g.ScalarFromInt64(-1),
+		}
+		scalars = append(scalars, randomScalars(g, 20)...)
+
+		for _, a := range scalars {
+			for _, b := range scalars {
+				if cmp := a.CmpCT(b); cmp != toInt(a).Cmp(toInt(b)) {
+					t.Fatalf("unexpected comparison %d of %s and %s", cmp, a.DebugString(), b.DebugString())
+				}
+			}
+
+			if a.CmpCT(nil) != toInt(a).Sign() {
+				t.Fatalf("unexpected comparison of %s with nil", a.DebugString())
+			}
+		}
+
+		// Scalars of all other groups, including those with the same encoding length, are rejected.
+		for _, other := range testTable {
+			if other.group == g {
+				continue
+			}
+
+			if err := testPanic(errWrongGroup, internal.ErrCastScalar, func() {
+				_ = g.NewScalar().CmpCT(other.group.NewScalar())
+			}); err != nil {
+				t.Fatalf("%s: %v", other.name, err)
+			}
+		}
+	})
+}
+
+func TestScalar_Cmp(t *testing.T) {
+	testAllGroups(t, func(group *testGroup) {
+		g := group.group
+		toInt := func(s *crypto.Scalar) *big.Int {
+			i, _ := new(big.Int).SetString(s.DebugString(), 10)
+			return i
+		}
+
+		scalars := []*crypto.Scalar{
+			g.NewScalar(),
+			g.NewScalar().One(),
+			g.NewScalar().SetUInt64(0xff),
+			g.NewScalar().SetUInt64(0x100),
+			g.ScalarFromInt64(-1),
+		}
+		scalars = append(scalars, randomScalars(g, 20)...)
+
+		for _, a := range scalars {
+			for _, b := range scalars {
+				if cmp := a.Cmp(b); cmp != toInt(a).Cmp(toInt(b)) || cmp != a.CmpCT(b) {
+					t.Fatalf("unexpected comparison %d of %s and %s", cmp, a.DebugString(), b.DebugString())
+				}
+			}
+
+			if a.Cmp(nil) != toInt(a).Sign() {
+				t.Fatalf("unexpected comparison of %s with nil", a.DebugString())
+			}
+		}
+
+		// Sorting by Cmp orders the numeric values.
+		slices.SortFunc(scalars, (*crypto.Scalar).Cmp)
+
+		for i := 1; i < len(scalars); i++ {
+			if toInt(scalars[i-1]).Cmp(toInt(scalars[i])) > 0 {
+				t.Fatal("scalars are not sorted")
+			}
+		}
+
+		if err := testPanic(errWrongGroup, internal.ErrCastScalar, func() {
+			_ = g.NewScalar().Cmp(otherGroup(g).NewScalar())
+		}); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+func TestImportScalarFrom(t *testing.T) {
+	errScalarField := errors.New("import scalar: the groups have different scalar fields")
+
+	testAllGroups(t, func(group *testGroup) {
+		for _, other := range testTable {
+			s := other.group.NewScalar().Random()
+			imported, err := group.group.ImportScalarFrom(other.group, s)
+
+			sameField := group.group.EqualScalarField(other.group)
+			if sameField != (group.group.Order() == other.group.Order()) {
+				t.Fatalf("%s: unexpected scalar field equality", other.name)
+			}
+
+			if !sameField {
+				if err == nil || err.Error() != errScalarField.Error() {
+					t.Fatalf("%s: expected error %q, got %v", other.name, errScalarField, err)
+				}
+
+				continue
+			}
+
+			if err != nil {
+				t.Fatalf("%s: unexpected error: %v", other.name, err)
+			}
+
+			if imported.DebugString() != s.DebugString() {
+				t.Fatalf("%s: %s", other.name, errExpectedEquality)
+			}
+
+			if group.group == other.group && imported.Equal(s) != 1 {
+				t.Fatal(errExpectedEquality)
+			}
+		}
+
+		if _, err := group.group.ImportScalarFrom(group.group, nil); err == nil {
+			t.Fatal("expected error on nil scalar")
+		}
+	})
+
+	// Ristretto255 and Edwards25519 share the same scalar field.
+	s := crypto.Edwards25519Sha512.NewScalar().Random()
+
+	r, err := crypto.Ristretto255Sha512.ImportScalarFrom(crypto.Edwards25519Sha512, s)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !bytes.Equal(r.Encode(), s.Encode()) {
+		t.Fatal(errExpectedEquality)
+	}
+}
+
+func TestScalar_DebugString(t *testing.T) {
+	testAllGroups(t, func(group *testGroup) {
+		g := group.group
+		order, _ := new(big.Int).SetString(g.Order(), 0)
+
+		if g.NewScalar().DebugString() != "0" {
+			t.Fatal(errExpectedEquality)
+		}
+
+		if g.NewScalar().SetUInt64(1234567890).DebugString() != "1234567890" {
+			t.Fatal(errExpectedEquality)
+		}
+
+		if g.ScalarFromInt64(-1).DebugString() != new(big.Int).Sub(order, big.NewInt(1)).String() {
+			t.Fatal(errExpectedEquality)
+		}
+
+		// The big.Int value of a random scalar, from its encoding in the group's endianness.
+		s := g.NewScalar().Random()
+		enc := s.Encode()
+
+		if g == crypto.Ristretto255Sha512 || g == crypto.Edwards25519Sha512 {
+			slices.Reverse(enc)
+		}
+
+		if s.DebugString() != new(big.Int).SetBytes(enc).String() {
+			t.Fatalf("unexpected decimal value %s for %s", s.DebugString(), s.Hex())
+		}
+	})
+}
+
+func TestScalar_SetWideBytes(t *testing.T) {
+	testAllGroups(t, func(group *testGroup) {
+		g := group.group
+		order, _ := new(big.Int).SetString(g.Order(), 0)
+		littleEndian := g == crypto.Ristretto255Sha512 || g == crypto.Edwards25519Sha512
+
+		for _, wide := range [][]byte{
+			internal.RandomBytes(2 * g.ScalarLength()),
+			bytes.Repeat([]byte{0xff}, 2*g.ScalarLength()),
+			make([]byte, 2*g.ScalarLength()),
+		} {
+			s := g.NewScalar()
+			if err := s.SetWideBytes(wide); err != nil {
+				t.Fatal(err)
+			}
+
+			v := slices.Clone(wide)
+			if littleEndian {
+				slices.Reverse(v)
+			}
+
+			expected := new(big.Int).SetBytes(v)
+			if s.DebugString() != expected.Mod(expected, order).String() {
+				t.Fatalf("unexpected reduction %s", s.DebugString())
+			}
+		}
+
+		expected := "scalar SetWideBytes: invalid scalar length"
+		for _, l := range []int{0, g.ScalarLength(), 2*g.ScalarLength() - 1, 2*g.ScalarLength() + 1} {
+			if err := g.NewScalar().SetWideBytes(make([]byte, l)); err == nil || err.Error() != expected {
+				t.Fatalf("expected error %q, got %v", expected, err)
+			}
+		}
+	})
+}
+
+func TestScalar_SplitHalves(t *testing.T) {
+	testAllGroups(t, func(group *testGroup) {
+		g := group.group
+		k := group.scalarLength * 4
+
+		// 2^k
+		shift := g.NewScalar().One()
+		for i := 0; i < k; i++ {
+			shift.Add(shift)
+		}
+
+		bound := new(big.Int).Lsh(big.NewInt(1), uint(k))
+
+		for _, s := range []*crypto.Scalar{
+			g.NewScalar(),
+			g.NewScalar().One(),
+			shift,
+			g.ScalarFromInt64(-1),
+			g.NewScalar().Random(),
+			g.NewScalar().Random(),
+		} {
+			hi, lo := s.SplitHalves()
+
+			if lo.Copy().Add(hi.Copy().Multiply(shift)).Equal(s) != 1 {
+				t.Fatalf("%s: %s", s.DebugString(), errExpectedEquality)
+			}
+
+			for _, h := range []*crypto.Scalar{hi, lo} {
+				v, _ := new(big.Int).SetString(h.DebugString(), 10)
+				if v.Cmp(bound) >= 0 {
+					t.Fatalf("half %s exceeds 2^%d", h.DebugString(), k)
+				}
+			}
+		}
+
+		if hi, lo := shift.SplitHalves(); !lo.IsZero() || hi.Equal(g.NewScalar().One()) != 1 {
+			t.Fatal(errExpectedEquality)
+		}
+	})
+}
+
+func TestNegateScalarBytes(t *testing.T) {
+	testAllGroups(t, func(group *testGroup) {
+		g := group.group
+
+		for _, s := range []*crypto.Scalar{g.NewScalar().One(), g.ScalarFromInt64(-1), g.NewScalar().Random()} {
+			neg, err := g.NegateScalarBytes(s.Encode())
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !bytes.Equal(neg, g.NewScalar().Subtract(s).Encode()) {
+				t.Fatal(errExpectedEquality)
+			}
+
+			if bytes.Equal(neg, s.Encode()) {
+				t.Fatal(errUnExpectedEquality)
+			}
+
+			// Double negation
+			neg, err = g.NegateScalarBytes(neg)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !bytes.Equal(neg, s.Encode()) {
+				t.Fatal(errExpectedEquality)
+			}
+		}
+
+		// Zero
+		zero := g.NewScalar().Encode()
+
+		neg, err := g.NegateScalarBytes(zero)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if !bytes.Equal(neg, zero) {
+			t.Fatal(errExpectedEquality)
+		}
+
+		// Invalid encodings
+		for _, b := range [][]byte{nil, make([]byte, group.scalarLength-1), bytes.Repeat([]byte{0xff}, group.scalarLength)} {
+			if _, err = g.NegateScalarBytes(b); err == nil {
+				t.Fatalf("expected error on %x", b)
+			}
+		}
+	})
+}
+
 func TestScalar_EncodedLength(t *testing.T) {
 	testAllGroups(t, func(group *testGroup) {
 		encodedScalar := group.group.NewScalar().Random().Encode()
@@ -264,11 +823,52 @@ func TestScalar_Arithmetic(t *testing.T) {
 		scalarTestAdd(t, group.group)
 		scalarTestSubtract(t, group.group)
 		scalarTestMultiply(t, group.group)
-		scalarTestPow(t, group.group)
+		scalarTestPow(t, group.group, (*crypto.Scalar).Pow)
+		scalarTestPow(t, group.group, (*crypto.Scalar).PowCT)
 		scalarTestInvert(t, group.group)
 	})
 }
 
+func TestScalar_MulAdd(t *testing.T) {
+	testAllGroups(t, func(group *testGroup) {
+		g := group.group
+
+		for i := 0; i < 10; i++ {
+			a, b, c := g.NewScalar().Random(), g.NewScalar().Random(), g.NewScalar().Random()
+			expected := a.Copy().Multiply(b).Add(c)
+
+			if a.Copy().MulAdd(b, c).Equal(expected) != 1 {
+				t.Fatal(errExpectedEquality)
+			}
+
+			// Aliasing the receiver.
+			if r := a.Copy(); r.MulAdd(r, r).Equal(a.Copy().Multiply(a).Add(a)) != 1 {
+				t.Fatal(errExpectedEquality)
+			}
+
+			if r := a.Copy(); r.MulAdd(b, r).Equal(a.Copy().Multiply(b).Add(a)) != 1 {
+				t.Fatal(errExpectedEquality)
+			}
+		}
+
+		// Reduction of the largest values: (-1) * (-1) + (-1) = 0.
+		minusOne := g.NewScalar().One().Negate()
+		if !minusOne.Copy().MulAdd(minusOne, minusOne).IsZero() {
+			t.Fatal("expected zero")
+		}
+
+		// nil is treated as 0.
+		a, c := g.NewScalar().Random(), g.NewScalar().Random()
+		if a.Copy().MulAdd(nil, c).Equal(c) != 1 || a.Copy().MulAdd(c, nil).Equal(a.Copy().Multiply(c)) != 1 {
+			t.Fatal(errExpectedEquality)
+		}
+
+		if !a.Copy().MulAdd(nil, nil).IsZero() {
+			t.Fatal("expected zero")
+		}
+	})
+}
+
 func scalarTestZero(t *testing.T, g crypto.Group) {
 	zero := g.NewScalar()
 	if !zero.IsZero() {
@@ -396,24 +996,24 @@ func scalarTestMultiply(t *testing.T, g crypto.Group) {
 	}
 }
 
-func scalarTestPow(t *testing.T, g crypto.Group) {
+func scalarTestPow(t *testing.T, g crypto.Group, pow func(s, exp *crypto.Scalar) *crypto.Scalar) {
 	// s**nil = 1
 	s := g.NewScalar().Random()
-	if s.Pow(nil).Equal(g.NewScalar().One()) != 1 {
+	if pow(s, nil).Equal(g.NewScalar().One()) != 1 {
 		t.Fatal("expected s**nil = 1")
 	}
 
 	// s**0 = 1
 	s = g.NewScalar().Random()
 	zero := g.NewScalar().Zero()
-	if s.Pow(zero).Equal(g.NewScalar().One()) != 1 {
+	if pow(s, zero).Equal(g.NewScalar().One()) != 1 {
 		t.Fatal("expected s**0 = 1")
 	}
 
 	// s**1 = s
 	s = g.NewScalar().Random()
 	exp := g.NewScalar().One()
-	if s.Copy().Pow(exp).Equal(s) != 1 {
+	if pow(s.Copy(), exp).Equal(s) != 1 {
 		t.Fatal("expected s**1 = s")
 	}
 
@@ -423,7 +1023,7 @@ func scalarTestPow(t *testing.T, g crypto.Group) {
 	s2 := s.Copy().Multiply(s)
 	exp.SetUInt64(2)
 
-	if s.Pow(exp).Equal(s2) != 1 {
+	if pow(s, exp).Equal(s2) != 1 {
 		t.Fatal("expected s**2 = s*s")
 	}
 
@@ -433,7 +1033,7 @@ func scalarTestPow(t *testing.T, g crypto.Group) {
 	s3.Multiply(s)
 	exp.SetUInt64(3)
 
-	if s.Pow(exp).Equal(s3) != 1 {
+	if pow(s, exp).Equal(s3) != 1 {
 		t.Fatal("expected s**3 = s*s*s")
 	}
 
@@ -442,7 +1042,7 @@ func scalarTestPow(t *testing.T, g crypto.Group) {
 	s.SetUInt64(5)
 	exp.SetUInt64(7)
 
-	res := s.Pow(exp)
+	res := pow(s, exp)
 	if res.Equal(result) != 1 {
 		t.Fatal("expected 5**7 = 78125")
 	}
@@ -455,7 +1055,7 @@ func scalarTestPow(t *testing.T, g crypto.Group) {
 	s.SetUInt64(3)
 	exp.SetUInt64(255)
 
-	res = s.Pow(exp)
+	res = pow(s, exp)
 	if res.Equal(result) != 1 {
 		t.Fatal(
 			"expected 3**255 = " +
@@ -471,7 +1071,7 @@ func scalarTestPow(t *testing.T, g crypto.Group) {
 	s.SetUInt64(7945232487465)
 	exp.SetUInt64(513)
 
-	res = s.Pow(exp)
+	res = pow(s, exp)
 	if res.Equal(result) != 1 {
 		t.Fatal("expect equality on 7945232487465**513")
 	}
@@ -504,7 +1104,7 @@ func scalarTestPow(t *testing.T, g crypto.Group) {
 
 	result = bigIntExp(t, g, iBase, iExp)
 
-	if s.Pow(exp).Equal(result) != 1 {
+	if pow(s, exp).Equal(result) != 1 {
 		t.Fatal("expected equality on random numbers")
 	}
 }
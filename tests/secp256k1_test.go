@@ -0,0 +1,232 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (C) 2020-2023 Daniel Bourdrez. All Rights Reserved.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree or at
+// https://spdx.org/licenses/MIT.html
+
+package group_test
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"testing"
+
+	"github.com/bytemare/crypto"
+)
+
+type recoveryVector struct {
+	r, s       string
+	msgHash    string
+	publicKey  string
+	recoveryID int
+}
+
+var recoveryVectors = []recoveryVector{
+	{
+		// private key 1, nonce 2, message "hello"
+		r:          "c6047f9441ed7d6d3045406e95c07cd85c778e4b8cef3ca7abac09b95c709ee5",
+		s:          "f97b66a750cf103dab96bdccadbd2fba991e44c72def8fa0ef414dd460193c25",
+		msgHash:    "2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824",
+		publicKey:  "0279be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798",
+		recoveryID: 0,
+	},
+	{
+		// message "sample"
+		r:          "432310e32cb80eb6503a26ce83cc165c783b870845fb8aad6d970889fcd7a6c8",
+		s:          "530128b6b81c548874a6305d93ed071ca6e05074d85863d4056ce89b02bfab69",
+		msgHash:    "af2bdbe1aa9b6ec1e2ade1d694f41fc71a831d0268e9891562113d8a62add1bf",
+		publicKey:  "032c8c31fc9f990c6b55e3865a184a4ce50e09481f2eaeb3e60ec1cea13a6ae645",
+		recoveryID: 0,
+	},
+	{
+		// message "test message"
+		r:          "99395a6672f060ca5ab747728048ad20ae0a52bb51beed783f231ba54e5c3813",
+		s:          "c4b152adf1eab2335bae6c4fc19007dfb8be1637dfe5208c829c3f7c5977bfa9",
+		msgHash:    "3f0a377ba0a4a460ecb616f6507ce0d8cfa3e704025d4fda3ed0c5ca05468728",
+		publicKey:  "031a1fd15fce078234aa292fc024178056bf006433c9b4bd208f59eb4c9efec95b",
+		recoveryID: 1,
+	},
+}
+
+func TestSecp256k1_RecoverPublicKey_Vectors(t *testing.T) {
+	g := crypto.Secp256k1
+
+	for i, v := range recoveryVectors {
+		r := decodeScalar(t, g, v.r)
+		s := decodeScalar(t, g, v.s)
+		pub := decodeElement(t, g, v.publicKey)
+
+		msgHash, err := hex.DecodeString(v.msgHash)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		q, err := g.RecoverPublicKey(r, s, v.recoveryID, msgHash)
+		if err != nil {
+			t.Fatalf("%d: unexpected error: %v", i, err)
+		}
+
+		if q.Equal(pub) != 1 {
+			t.Fatalf("%d: %s", i, errExpectedEquality)
+		}
+
+		// Flipping the parity bit must yield another key.
+		q, err = g.RecoverPublicKey(r, s, v.recoveryID^1, msgHash)
+		if err == nil && q.Equal(pub) == 1 {
+			t.Fatalf("%d: %s", i, errUnExpectedEquality)
+		}
+	}
+}
+
+func TestSecp256k1_RecoverPublicKey_Signatures(t *testing.T) {
+	g := crypto.Secp256k1
+	msgHash := sha256.Sum256([]byte("message"))
+	e := decodeScalar(t, g, hex.EncodeToString(msgHash[:]))
+
+	for i := 0; i < 10; i++ {
+		sk := g.NewScalar().Random()
+		pk := g.Base().Multiply(sk)
+
+		// Sign with a random nonce: r = (k*G).x mod n, s = k^-1 * (e + r*sk)
+		k := g.NewScalar().Random()
+		R := g.Base().Multiply(k)
+		encR := R.Encode()
+
+		r := g.NewScalar()
+		if err := r.Decode(encR[1:]); err != nil {
+			// the x-coordinate exceeds the order, which is negligibly rare
+			continue
+		}
+
+		s := r.Copy().Multiply(sk).Add(e).Multiply(k.Copy().Invert())
+
+		q, err := g.RecoverPublicKey(r, s, int(encR[0]&1), msgHash[:])
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if q.Equal(pk) != 1 {
+			t.Fatal(errExpectedEquality)
+		}
+	}
+}
+
+func TestSecp256k1_RecoverPublicKey_Errors(t *testing.T) {
+	g := crypto.Secp256k1
+	errRecoveryID := errors.New("public key recovery: invalid recovery id")
+	errRecoveryFailed := errors.New("public key recovery: public key recovery failed")
+	v := recoveryVectors[0]
+	r := decodeScalar(t, g, v.r)
+	s := decodeScalar(t, g, v.s)
+	msgHash, _ := hex.DecodeString(v.msgHash)
+
+	// Invalid recovery ids
+	for _, id := range []int{-1, 4, 27} {
+		if _, err := g.RecoverPublicKey(r, s, id, msgHash); err == nil || err.Error() != errRecoveryID.Error() {
+			t.Fatalf("expected error %q, got %v", errRecoveryID, err)
+		}
+	}
+
+	// r + n exceeds the field order
+	for _, id := range []int{2, 3} {
+		if _, err := g.RecoverPublicKey(r, s, id, msgHash); err == nil || err.Error() != errRecoveryFailed.Error() {
+			t.Fatalf("expected error %q, got %v", errRecoveryFailed, err)
+		}
+	}
+
+	// zero scalars
+	if _, err := g.RecoverPublicKey(g.NewScalar(), s, 0, msgHash); err == nil ||
+		err.Error() != errRecoveryFailed.Error() {
+		t.Fatalf("expected error %q, got %v", errRecoveryFailed, err)
+	}
+
+	if _, err := g.RecoverPublicKey(r, g.NewScalar(), 0, msgHash); err == nil ||
+		err.Error() != errRecoveryFailed.Error() {
+		t.Fatalf("expected error %q, got %v", errRecoveryFailed, err)
+	}
+
+	// nil scalars
+	if _, err := g.RecoverPublicKey(nil, s, 0, msgHash); err == nil {
+		t.Fatal("expected error on nil scalar")
+	}
+
+	// Other groups are not supported
+	testAllGroups(t, func(group *testGroup) {
+		if group.group == crypto.Secp256k1 {
+			return
+		}
+
+		if _, err := group.group.RecoverPublicKey(
+			group.group.NewScalar(), group.group.NewScalar(), 0, msgHash,
+		); err == nil {
+			t.Fatal("expected error on unsupported group")
+		}
+	})
+}
+
+func TestSecp256k1_MultiplyVartime(t *testing.T) {
+	g := crypto.Secp256k1
+	one := g.NewScalar().One()
+	scalars := []*crypto.Scalar{
+		g.NewScalar(),
+		one,
+		g.NewScalar().SetUInt64(2),
+		g.NewScalar().Subtract(one),
+		// lambda and lambda + 1
+		decodeScalar(t, g, "5363ad4cc05c30e0a5261c028812645a122e22ea20816678df02967c1b23bd72"),
+		decodeScalar(t, g, "5363ad4cc05c30e0a5261c028812645a122e22ea20816678df02967c1b23bd73"),
+	}
+
+	for i := 0; i < 50; i++ {
+		scalars = append(scalars, g.NewScalar().Random())
+	}
+
+	points := []*crypto.Element{g.Base(), g.NewElement()}
+	for i := 0; i < 5; i++ {
+		points = append(points, g.Base().Multiply(g.NewScalar().Random()))
+	}
+
+	for _, p := range points {
+		for _, s := range scalars {
+			if p.Copy().MultiplyVartime(s).Equal(p.Copy().Multiply(s)) != 1 {
+				t.Fatalf("%s * %s: %s", p.Hex(), s.Hex(), errExpectedEquality)
+			}
+		}
+	}
+
+	if !g.Base().MultiplyVartime(nil).IsIdentity() {
+		t.Fatal("expected identity")
+	}
+}
+
+func TestSecp256k1_Equal(t *testing.T) {
+	g := crypto.Secp256k1
+	p := g.Base().Multiply(g.NewScalar().Random())
+
+	// The same point in different projective representations.
+	doubled := p.Copy().Double()
+	sum := p.Copy().Add(p)
+	decoded := decodeElement(t, g, doubled.Hex())
+
+	for _, e := range []*crypto.Element{sum, decoded, doubled.Copy()} {
+		if doubled.Equal(e) != 1 || e.Equal(doubled) != 1 {
+			t.Fatal(errExpectedEquality)
+		}
+	}
+
+	// The negation only differs in the parity of y.
+	if p.Equal(p.Copy().Negate()) != 0 {
+		t.Fatal(errUnExpectedEquality)
+	}
+
+	if p.Equal(g.NewElement()) != 0 || g.NewElement().Equal(p) != 0 {
+		t.Fatal(errUnExpectedEquality)
+	}
+
+	if g.NewElement().Equal(p.Copy().Subtract(p)) != 1 {
+		t.Fatal(errExpectedEquality)
+	}
+}
@@ -66,6 +66,15 @@ func testPanic(s string, expectedError error, f func()) error {
 	return nil
 }
 
+func decodeHex(t *testing.T, h string) []byte {
+	b, err := hex.DecodeString(h)
+	if err != nil {
+		t.Error(err)
+	}
+
+	return b
+}
+
 func decodeScalar(t *testing.T, g crypto.Group, input string) *crypto.Scalar {
 	b, err := hex.DecodeString(input)
 	if err != nil {
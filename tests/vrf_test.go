@@ -0,0 +1,375 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (C) 2020-2023 Daniel Bourdrez. All Rights Reserved.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree or at
+// https://spdx.org/licenses/MIT.html
+
+package group_test
+
+import (
+	"bytes"
+	"crypto/elliptic"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/hex"
+	"errors"
+	"math/big"
+	"slices"
+	"testing"
+
+	"github.com/bytemare/crypto"
+	"github.com/bytemare/crypto/internal"
+	"github.com/bytemare/crypto/internal/edwards25519"
+)
+
+func isVRFGroup(g crypto.Group) bool {
+	return g == crypto.P256Sha256 || g == crypto.Edwards25519Sha512
+}
+
+// TestNonceRFC6979 uses the P-256 with SHA-256 vectors from RFC 6979 A.2.5, which are used in ECVRF-P256-SHA256-SSWU.
+func TestNonceRFC6979(t *testing.T) {
+	x, _ := hex.DecodeString("c9afa9d845ba75166b5c215767b1d6934e50c3db36e89b127b8a622b120f6721")
+	q := elliptic.P256().Params().N
+
+	tests := []struct {
+		message string
+		k       string
+	}{
+		{"sample", "a6e3c57dd01abe90086538398355dd4c3b17aa873382b0f24d6129493d8aad60"},
+		{"test", "d16b6ae827f17175e040871a1c7ec3500192c4c92677336ec2537acaee0008e0"},
+	}
+
+	for _, test := range tests {
+		k := internal.NonceRFC6979(crypto.P256Sha256.HashFunc(), q, x, []byte(test.message))
+		if hex.EncodeToString(k.FillBytes(make([]byte, 32))) != test.k {
+			t.Fatalf("unexpected nonce for %q: %x", test.message, k)
+		}
+	}
+}
+
+func TestVRF(t *testing.T) {
+	input := []byte("sample")
+
+	testAllGroups(t, func(group *testGroup) {
+		if !isVRFGroup(group.group) {
+			return
+		}
+
+		priv := group.group.NewScalar().Random()
+		pub := group.group.Base().Multiply(priv)
+
+		beta, proof := group.group.VRFProve(priv, input)
+		if len(proof) != group.elementLength+16+group.scalarLength {
+			t.Fatalf("unexpected proof length %d", len(proof))
+		}
+
+		if len(beta) != group.hash.Size() {
+			t.Fatalf("unexpected output length %d", len(beta))
+		}
+
+		out, ok := group.group.VRFVerify(pub, input, proof)
+		if !ok {
+			t.Fatal("expected valid proof")
+		}
+
+		if !bytes.Equal(beta, out) {
+			t.Fatal(errExpectedEquality)
+		}
+
+		// Proving is deterministic.
+		beta2, proof2 := group.group.VRFProve(priv, input)
+		if !bytes.Equal(beta, beta2) || !bytes.Equal(proof, proof2) {
+			t.Fatal(errExpectedEquality)
+		}
+
+		// Another input yields another output.
+		beta2, _ = group.group.VRFProve(priv, []byte("other input"))
+		if bytes.Equal(beta, beta2) {
+			t.Fatal(errUnExpectedEquality)
+		}
+	})
+}
+
+func TestVRF_Invalid(t *testing.T) {
+	input := []byte("sample")
+
+	testAllGroups(t, func(group *testGroup) {
+		if !isVRFGroup(group.group) {
+			return
+		}
+
+		priv := group.group.NewScalar().Random()
+		pub := group.group.Base().Multiply(priv)
+		_, proof := group.group.VRFProve(priv, input)
+
+		// Wrong input
+		if _, ok := group.group.VRFVerify(pub, []byte("other input"), proof); ok {
+			t.Fatal("expected invalid proof for another input")
+		}
+
+		// Wrong key
+		other := group.group.Base().Multiply(group.group.NewScalar().Random())
+		if _, ok := group.group.VRFVerify(other, input, proof); ok {
+			t.Fatal("expected invalid proof for another key")
+		}
+
+		// Tampered proof
+		for _, i := range []int{group.elementLength + 1, len(proof) - 8} {
+			tampered := bytes.Clone(proof)
+			tampered[i] ^= 0x01
+
+			if _, ok := group.group.VRFVerify(pub, input, tampered); ok {
+				t.Fatalf("expected invalid proof for tampered byte %d", i)
+			}
+		}
+
+		// Wrong length
+		if _, ok := group.group.VRFVerify(pub, input, proof[:len(proof)-1]); ok {
+			t.Fatal("expected invalid proof for truncated proof")
+		}
+
+		// Identity or nil public key
+		if _, ok := group.group.VRFVerify(group.group.NewElement(), input, proof); ok {
+			t.Fatal("expected invalid proof for identity public key")
+		}
+
+		if _, ok := group.group.VRFVerify(nil, input, proof); ok {
+			t.Fatal("expected invalid proof for nil public key")
+		}
+	})
+}
+
+// TestVRF_Vectors uses the RFC 9381 ECVRF-P256-SHA256-SSWU example 13 and ECVRF-EDWARDS25519-SHA512-ELL2 example 19.
+func TestVRF_Vectors(t *testing.T) {
+	// P256, with the secret key from RFC 6979 A.2.5.
+	g := crypto.P256Sha256
+	x := decodeScalar(t, g, "c9afa9d845ba75166b5c215767b1d6934e50c3db36e89b127b8a622b120f6721")
+	beta, proof := g.VRFProve(x, []byte("sample"))
+
+	pi := "0331d984ca8fece9cbb9a144c0d53df3c4c7a33080c1e02ddb1a96a365394c7888782fffde7b842c38c20c08de6ec6c2" +
+		"e7027a97000f2c9fa4425d5c03e639fb48fde58114d755985498d7eb234cf4aed9"
+	if hex.EncodeToString(proof) != pi {
+		t.Fatalf("unexpected proof %x", proof)
+	}
+
+	if hex.EncodeToString(beta) != "21e66dc9747430f17ed9efeda054cf4a264b097b9e8956a1787526ed00dc664b" {
+		t.Fatalf("unexpected output %x", beta)
+	}
+
+	if out, ok := g.VRFVerify(g.Base().Multiply(x), []byte("sample"), proof); !ok || !bytes.Equal(out, beta) {
+		t.Fatal("expected valid proof")
+	}
+
+	// Edwards25519, with the secret key from RFC 8032 section 7.1 TEST 1.
+	g = crypto.Edwards25519Sha512
+	seed := decodeHex(t, "9d61b19deffd5a60ba844af492ec2cc44449c5697b326919703bac031cae7f60")
+
+	beta, proof, err := g.VRFProveFromSeed(seed, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	pi = "7d9c633ffeee27349264cf5c667579fc583b4bda63ab71d001f89c10003ab46f14adf9a3cd8b8412d9038531e865c341cafa73589b023d" +
+		"14311c331a9ad15ff2fb37831e00f0acaa6d73bc9997b06501"
+	if hex.EncodeToString(proof) != pi {
+		t.Fatalf("unexpected proof %x", proof)
+	}
+
+	b := "9d574bf9b8302ec0fc1e21c3ec5368269527b87b462ce36dab2d14ccf80c53cc" +
+		"cf6758f058c5b1c856b116388152bbe509ee3b9ecfe63d93c3b4346c1fbc6c54"
+	if hex.EncodeToString(beta) != b {
+		t.Fatalf("unexpected output %x", beta)
+	}
+
+	pub := decodeElement(t, g, "d75a980182b10ab7d54bfed3c964073a0ee172f3daa62325af021a68f707511a")
+	if out, ok := g.VRFVerify(pub, nil, proof); !ok || !bytes.Equal(out, beta) {
+		t.Fatal("expected valid proof")
+	}
+
+	// Invalid seed length
+	if _, _, err = g.VRFProveFromSeed(seed[:31], nil); err == nil {
+		t.Fatal("expected error on short seed")
+	}
+
+	expected := "VRFProveFromSeed: operation not supported by this group"
+	if _, _, err = crypto.P256Sha256.VRFProveFromSeed(seed, nil); err == nil || err.Error() != expected {
+		t.Fatalf("expected error %q, got %v", expected, err)
+	}
+}
+
+// TestVRF_LowOrderKey builds a proof for a public key of order 8 that satisfies the verification equations, such that
+// only the rejection of low order public keys can invalidate it.
+func TestVRF_LowOrderKey(t *testing.T) {
+	g := crypto.Edwards25519Sha512
+	input := []byte("sample")
+
+	// Points of order 8 on Edwards25519, used as public key and as Gamma.
+	lowOrder := decodeElement(t, g, "c7176a703d4dd84fba3c0b760d10670f2a2053fa2c39ccc64ec7fd7792ac037a")
+	gamma := lowOrder.Copy().Negate()
+
+	dst := []byte("ECVRF_" + edwards25519.E2C + "\x04")
+	h := g.EncodeToGroup(slices.Concat(lowOrder.Encode(), input), dst)
+
+	// With a challenge c that is a multiple of 8, c*Y and c*Gamma are the identity, and U = k*B and V = k*H satisfy
+	// U = s*B - c*Y and V = s*H - c*Gamma for s = k.
+	var k *crypto.Scalar
+	var cString []byte
+
+	for {
+		k = g.NewScalar().Random()
+		challenge := sha512.New()
+		challenge.Write([]byte{0x04, 0x02})
+
+		for _, p := range []*crypto.Element{lowOrder, h, gamma, g.Base().Multiply(k), h.Copy().Multiply(k)} {
+			challenge.Write(p.Encode())
+		}
+
+		challenge.Write([]byte{0x00})
+		cString = challenge.Sum(nil)[:16]
+
+		if cString[0]&0x07 == 0 {
+			break
+		}
+	}
+
+	proof := slices.Concat(gamma.Encode(), cString, k.Encode())
+
+	if _, ok := g.VRFVerify(lowOrder, input, proof); ok {
+		t.Fatal("expected invalid proof for low order public key")
+	}
+}
+
+func TestVRF_Unsupported(t *testing.T) {
+	errUnsupported := errors.New("operation not supported by this group")
+	input := sha256.Sum256([]byte("input"))
+
+	testAllGroups(t, func(group *testGroup) {
+		if isVRFGroup(group.group) {
+			return
+		}
+
+		if err := testPanic("unsupported group", errUnsupported, func() {
+			_, _ = group.group.VRFProve(group.group.NewScalar().Random(), input[:])
+		}); err != nil {
+			t.Fatal(err)
+		}
+
+		if err := testPanic("unsupported group", errUnsupported, func() {
+			_, _ = group.group.VRFVerify(group.group.Base(), input[:], nil)
+		}); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+func TestPointToString(t *testing.T) {
+	testAllGroups(t, func(group *testGroup) {
+		g := group.group
+
+		// Canonical encoding for non-identity elements
+		e := g.Base().Multiply(g.NewScalar().Random())
+		if !bytes.Equal(g.PointToString(e), e.Encode()) {
+			t.Fatal(errExpectedEquality)
+		}
+
+		d, err := g.StringToPoint(g.PointToString(e))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if d.Equal(e) != 1 {
+			t.Fatal(errExpectedEquality)
+		}
+
+		// Identity
+		id := g.PointToString(g.NewElement())
+
+		switch g {
+		case crypto.Ristretto255Sha512, crypto.Edwards25519Sha512:
+			if hex.EncodeToString(id) != group.identity {
+				t.Fatalf("unexpected identity encoding %x", id)
+			}
+		default:
+			if !bytes.Equal(id, []byte{0x00}) {
+				t.Fatalf("unexpected identity encoding %x", id)
+			}
+		}
+
+		d, err = g.StringToPoint(id)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if !d.IsIdentity() {
+			t.Fatal("expected identity")
+		}
+
+		// Invalid encodings
+		invalid := [][]byte{
+			nil,
+			{0x01},
+			make([]byte, group.elementLength-1),
+			make([]byte, group.elementLength+1),
+		}
+
+		for _, b := range invalid {
+			if _, err = g.StringToPoint(b); err == nil {
+				t.Fatalf("expected error on %x", b)
+			}
+		}
+	})
+}
+
+// TestPointToString_Vectors uses the public keys of the RFC 9381 ECVRF-P256-SHA256-SSWU and
+// ECVRF-EDWARDS25519-SHA512-ELL2 examples.
+func TestPointToString_Vectors(t *testing.T) {
+	// P256, with the secret key from RFC 6979 A.2.5.
+	g := crypto.P256Sha256
+	x := decodeScalar(t, g, "c9afa9d845ba75166b5c215767b1d6934e50c3db36e89b127b8a622b120f6721")
+	pk := "0360fed4ba255a9d31c961eb74c6356d68c049b8923b61fa6ce669622e60f29fb6"
+
+	if hex.EncodeToString(g.PointToString(g.Base().Multiply(x))) != pk {
+		t.Fatal(errExpectedEquality)
+	}
+
+	// Edwards25519, with the secret key from RFC 8032 section 7.1 TEST 1.
+	g = crypto.Edwards25519Sha512
+	seed := decodeHex(t, "9d61b19deffd5a60ba844af492ec2cc44449c5697b326919703bac031cae7f60")
+	h := sha512.Sum512(seed)
+	h[0] &= 248
+	h[31] &= 127
+	h[31] |= 64
+
+	// Reduce the clamped secret, in little-endian, modulo the order.
+	order, _ := new(big.Int).SetString(g.Order(), 0)
+	k := new(big.Int).SetBytes(reverse(h[:32]))
+	k.Mod(k, order)
+	x = g.NewScalar()
+
+	if err := x.Decode(reverse(k.FillBytes(make([]byte, 32)))); err != nil {
+		t.Fatal(err)
+	}
+
+	pk = "d75a980182b10ab7d54bfed3c964073a0ee172f3daa62325af021a68f707511a"
+	if hex.EncodeToString(g.PointToString(g.Base().Multiply(x))) != pk {
+		t.Fatal(errExpectedEquality)
+	}
+
+	e, err := g.StringToPoint(decodeHex(t, pk))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if e.Equal(g.Base().Multiply(x)) != 1 {
+		t.Fatal(errExpectedEquality)
+	}
+}
+
+func reverse(b []byte) []byte {
+	r := slices.Clone(b)
+	slices.Reverse(r)
+
+	return r
+}
@@ -0,0 +1,183 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (C) 2020-2023 Daniel Bourdrez. All Rights Reserved.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree or at
+// https://spdx.org/licenses/MIT.html
+
+package group_test
+
+import (
+	"bytes"
+	"crypto/sha512"
+	"encoding/hex"
+	"testing"
+
+	"filippo.io/edwards25519"
+
+	"github.com/bytemare/crypto"
+)
+
+type x25519Vector struct {
+	scalar, u, out string
+}
+
+var x25519Vectors = []x25519Vector{
+	// RFC 7748 section 5.2
+	{
+		scalar: "a546e36bf0527c9d3b16154b82465edd62144c0ac1fc5a18506a2244ba449ac4",
+		u:      "e6db6867583030db3594c1a424b15f7c726624ec26b3353b10a903a6d0ab1c4c",
+		out:    "c3da55379de9c6908e94ea4df28d084f32eccf03491c71f754b4075577a28552",
+	},
+	{
+		scalar: "4b66e9d4d1b4673c5ad22691957d6af5c11b6421e0ea01d42ca4169e7918ba0d",
+		u:      "e5210f12786811d3f4b7959d0538ae2c31dbe7106fc03c3efc4cd549c715a493",
+		out:    "95cbde9476e8907d7aade45cb4b873f88b595a68799fa152e6f8f7647aac7957",
+	},
+	// RFC 7748 section 6.1, Alice and Bob's public keys
+	{
+		scalar: "77076d0a7318a57d3c16c17251b26645df4c2f87ebc0992ab177fba51db92c2a",
+		u:      "0900000000000000000000000000000000000000000000000000000000000000",
+		out:    "8520f0098930a754748b7ddcb43ef75a0dbf3a0d26381af4eba4a98eaa9b4e6a",
+	},
+	{
+		scalar: "5dab087e624a8a4b79e17f8b83800ee66f3bb1292618b6fd1c2f8b27ff88e0eb",
+		u:      "0900000000000000000000000000000000000000000000000000000000000000",
+		out:    "de9edb7d7b7dc1b4d35b61c2ece435373f8343c85b78674dadfc7e146f882b4f",
+	},
+	// RFC 7748 section 6.1, shared secret
+	{
+		scalar: "77076d0a7318a57d3c16c17251b26645df4c2f87ebc0992ab177fba51db92c2a",
+		u:      "de9edb7d7b7dc1b4d35b61c2ece435373f8343c85b78674dadfc7e146f882b4f",
+		out:    "4a5d9d5ba4ce2de1728e3bf480350f25e07e21c947d19e3376f09b3c1e161742",
+	},
+	{
+		scalar: "5dab087e624a8a4b79e17f8b83800ee66f3bb1292618b6fd1c2f8b27ff88e0eb",
+		u:      "8520f0098930a754748b7ddcb43ef75a0dbf3a0d26381af4eba4a98eaa9b4e6a",
+		out:    "4a5d9d5ba4ce2de1728e3bf480350f25e07e21c947d19e3376f09b3c1e161742",
+	},
+}
+
+func TestX25519_Vectors(t *testing.T) {
+	for i, v := range x25519Vectors {
+		out, err := crypto.Edwards25519Sha512.X25519(decodeHex(t, v.scalar), decodeHex(t, v.u))
+		if err != nil {
+			t.Fatalf("%d: unexpected error: %v", i, err)
+		}
+
+		if hex.EncodeToString(out) != v.out {
+			t.Fatalf("%d: expected %s, got %x", i, v.out, out)
+		}
+	}
+}
+
+// TestX25519_Iterated runs the first iterations of the RFC 7748 section 5.2 iterated test.
+func TestX25519_Iterated(t *testing.T) {
+	k := decodeHex(t, "0900000000000000000000000000000000000000000000000000000000000000")
+	u := bytes.Clone(k)
+
+	for i := 0; i < 1000; i++ {
+		out, err := crypto.Edwards25519Sha512.X25519(k, u)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		u, k = k, out
+
+		switch i {
+		case 0:
+			if hex.EncodeToString(k) != "422c8e7a6227d7bca1350b3e2bb7279f7897b87bb6854b783c60e80311ae3079" {
+				t.Fatalf("unexpected result after 1 iteration: %x", k)
+			}
+		case 999:
+			if hex.EncodeToString(k) != "684cf59ba83309552800ef566f2f4d3c1c3887c49360e3875f2eb94d99532c51" {
+				t.Fatalf("unexpected result after 1000 iterations: %x", k)
+			}
+		}
+	}
+}
+
+func TestX25519_Errors(t *testing.T) {
+	g := crypto.Edwards25519Sha512
+	valid := make([]byte, 32)
+
+	if _, err := g.X25519(valid[:31], valid); err == nil {
+		t.Fatal("expected error on short scalar")
+	}
+
+	if _, err := g.X25519(valid, valid[:31]); err == nil {
+		t.Fatal("expected error on short u-coordinate")
+	}
+
+	testAllGroups(t, func(group *testGroup) {
+		if group.group == crypto.Edwards25519Sha512 {
+			return
+		}
+
+		if _, err := group.group.X25519(valid, valid); err == nil {
+			t.Fatal("expected error on unsupported group")
+		}
+	})
+}
+
+func TestScalar_DecodeClamped(t *testing.T) {
+	g := crypto.Edwards25519Sha512
+
+	// The public keys of RFC 7748 section 6.1, derived with the Edwards25519 base point.
+	for _, v := range x25519Vectors {
+		if v.u != "0900000000000000000000000000000000000000000000000000000000000000" {
+			continue
+		}
+
+		secret := decodeHex(t, v.scalar)
+		reference := bytes.Clone(secret)
+
+		s := g.NewScalar()
+		if err := s.DecodeClamped(secret); err != nil {
+			t.Fatal(err)
+		}
+
+		if !bytes.Equal(secret, reference) {
+			t.Fatal("unexpected modification of the input")
+		}
+
+		p, err := new(edwards25519.Point).SetBytes(g.Base().Multiply(s).Encode())
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if hex.EncodeToString(p.BytesMontgomery()) != v.out {
+			t.Fatalf("expected %s, got %x", v.out, p.BytesMontgomery())
+		}
+	}
+
+	// The public key of RFC 8032 section 7.1 TEST 1.
+	h := sha512.Sum512(decodeHex(t, "9d61b19deffd5a60ba844af492ec2cc44449c5697b326919703bac031cae7f60"))
+	s := g.NewScalar()
+
+	if err := s.DecodeClamped(h[:32]); err != nil {
+		t.Fatal(err)
+	}
+
+	pk := "d75a980182b10ab7d54bfed3c964073a0ee172f3daa62325af021a68f707511a"
+	if g.Base().Multiply(s).Hex() != pk {
+		t.Fatal(errExpectedEquality)
+	}
+
+	expected := "scalar DecodeClamped: invalid scalar length"
+	if err := s.DecodeClamped(h[:31]); err == nil || err.Error() != expected {
+		t.Fatalf("expected error %q, got %v", expected, err)
+	}
+
+	testAllGroups(t, func(group *testGroup) {
+		if group.group == crypto.Edwards25519Sha512 {
+			return
+		}
+
+		expected := "scalar DecodeClamped: operation not supported by this group"
+		if err := group.group.NewScalar().DecodeClamped(h[:32]); err == nil || err.Error() != expected {
+			t.Fatalf("expected error %q, got %v", expected, err)
+		}
+	})
+}
@@ -0,0 +1,307 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (C) 2020-2023 Daniel Bourdrez. All Rights Reserved.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree or at
+// https://spdx.org/licenses/MIT.html
+
+package crypto
+
+import (
+	"crypto/subtle"
+	"fmt"
+	"math/big"
+	"slices"
+
+	"github.com/bytemare/crypto/internal"
+	"github.com/bytemare/crypto/internal/edwards25519"
+	"github.com/bytemare/crypto/internal/nist"
+)
+
+const (
+	vrfChallengeLength = 16
+	vrfDSTPrefix       = "ECVRF_"
+
+	vrfDomainSeparatorChallenge = 0x02
+	vrfDomainSeparatorProof     = 0x03
+	vrfDomainSeparatorBack      = 0x00
+)
+
+// vrfSuite holds the RFC 9381 parameters of an ECVRF ciphersuite.
+type vrfSuite struct {
+	e2c          string
+	suite        byte
+	cofactor     int
+	littleEndian bool
+}
+
+// vrfSuite returns the RFC 9381 ECVRF ciphersuite associated to the group, and panics if there is none.
+func (g Group) vrfSuite() *vrfSuite {
+	switch g {
+	case P256Sha256:
+		// ECVRF-P256-SHA256-SSWU
+		return &vrfSuite{e2c: nist.E2CP256, suite: 0x02, cofactor: 1, littleEndian: false}
+	case Edwards25519Sha512:
+		// ECVRF-EDWARDS25519-SHA512-ELL2
+		return &vrfSuite{e2c: edwards25519.E2C, suite: 0x04, cofactor: 8, littleEndian: true}
+	default:
+		panic(errUnsupported)
+	}
+}
+
+// stringToScalar implements string_to_int for a short input, padding it to the length of a scalar encoding.
+func (s *vrfSuite) stringToScalar(g Group, in []byte) (*Scalar, error) {
+	b := make([]byte, g.ScalarLength())
+	if s.littleEndian {
+		copy(b, in)
+	} else {
+		copy(b[len(b)-len(in):], in)
+	}
+
+	sc := g.NewScalar()
+	if err := sc.Decode(b); err != nil {
+		return nil, err
+	}
+
+	return sc, nil
+}
+
+// encodeToCurve implements ECVRF_encode_to_curve_h2c_suite.
+func (s *vrfSuite) encodeToCurve(g Group, pk, alpha []byte) *Element {
+	dst := make([]byte, 0, len(vrfDSTPrefix)+len(s.e2c)+1)
+	dst = append(dst, vrfDSTPrefix...)
+	dst = append(dst, s.e2c...)
+	dst = append(dst, s.suite)
+
+	return g.EncodeToGroup(slices.Concat(pk, alpha), dst)
+}
+
+// challenge implements ECVRF_challenge_generation.
+func (s *vrfSuite) challenge(g Group, points ...*Element) []byte {
+	h := g.HashFunc().New()
+	_, _ = h.Write([]byte{s.suite, vrfDomainSeparatorChallenge})
+
+	for _, p := range points {
+		_, _ = h.Write(p.Encode())
+	}
+
+	_, _ = h.Write([]byte{vrfDomainSeparatorBack})
+
+	return h.Sum(nil)[:vrfChallengeLength]
+}
+
+// proofToHash implements ECVRF_proof_to_hash given the decoded Gamma point.
+func (s *vrfSuite) proofToHash(g Group, gamma *Element) []byte {
+	p := gamma.Copy()
+	for i := 1; i < s.cofactor; i <<= 1 {
+		p.Double()
+	}
+
+	h := g.HashFunc().New()
+	_, _ = h.Write([]byte{s.suite, vrfDomainSeparatorProof})
+	_, _ = h.Write(p.Encode())
+	_, _ = h.Write([]byte{vrfDomainSeparatorBack})
+
+	return h.Sum(nil)
+}
+
+// nonce implements ECVRF_nonce_generation. For P256, this follows RFC 6979. For Edwards25519, truncated is the second
+// half of the hash of the RFC 8032 seed. If it is nil, the secret is a scalar and not a seed, and the RFC 8032 nonce
+// derivation is applied to the hash of the scalar's encoding.
+func (s *vrfSuite) nonce(g Group, priv *Scalar, truncated, hString []byte) *Scalar {
+	if !s.littleEndian {
+		k := internal.NonceRFC6979(g.HashFunc(), g.OrderBigInt(), priv.Encode(), hString)
+
+		nonce := g.NewScalar()
+		if err := nonce.Decode(k.FillBytes(make([]byte, g.ScalarLength()))); err != nil {
+			panic(err)
+		}
+
+		return nonce
+	}
+
+	if truncated == nil {
+		hashedSK := g.HashFunc().New()
+		_, _ = hashedSK.Write(priv.Encode())
+		truncated = hashedSK.Sum(nil)[g.ScalarLength():]
+	}
+
+	h := g.HashFunc().New()
+	_, _ = h.Write(truncated)
+	_, _ = h.Write(hString)
+	kString := h.Sum(nil)
+
+	// string_to_int(k_string) mod q, with k_string in little-endian.
+	slices.Reverse(kString)
+	k := new(big.Int).SetBytes(kString)
+	k.Mod(k, g.OrderBigInt())
+
+	b := k.FillBytes(make([]byte, g.ScalarLength()))
+	slices.Reverse(b)
+
+	nonce := g.NewScalar()
+	if err := nonce.Decode(b); err != nil {
+		panic(err)
+	}
+
+	return nonce
+}
+
+// isSEC1 returns whether the group's elements are encoded following SEC 1 v2 section 2.3.3 with point compression.
+func (g Group) isSEC1() bool {
+	switch g {
+	case P256Sha256, P384Sha384, P521Sha512, Secp256k1:
+		return true
+	default:
+		return false
+	}
+}
+
+// PointToString implements the RFC 9381 point_to_string function, which is the canonical encoding of the element,
+// except for the identity element of groups using the SEC 1 encoding, which is encoded as the single 0x00 byte.
+func (g Group) PointToString(e *Element) []byte {
+	if g.isSEC1() && e.IsIdentity() {
+		return []byte{0x00}
+	}
+
+	return e.Encode()
+}
+
+// StringToPoint implements the RFC 9381 string_to_point function, the inverse of PointToString, and returns an error
+// if the input does not decode to a valid element. Contrary to Decode, the encoding of the identity element is
+// accepted.
+func (g Group) StringToPoint(b []byte) (*Element, error) {
+	if subtle.ConstantTimeCompare(b, g.PointToString(g.NewElement())) == 1 {
+		return g.NewElement(), nil
+	}
+
+	e := g.NewElement()
+	if err := e.Decode(b); err != nil {
+		return nil, fmt.Errorf("string_to_point: %w", err)
+	}
+
+	return e, nil
+}
+
+// VRFProve returns the RFC 9381 ECVRF output beta and its proof for the input, using the secret key priv. It
+// implements ECVRF-P256-SHA256-SSWU for P256Sha256 and ECVRF-EDWARDS25519-SHA512-ELL2 for Edwards25519Sha512, and
+// panics for other groups. Note that for Edwards25519 the secret key is the scalar and not the RFC 8032 seed, and that
+// the nonce is therefore derived from the scalar: the resulting proofs are valid but differ from the RFC test vectors.
+// Use VRFProveFromSeed to derive the key and nonce from the seed as RFC 9381 specifies.
+func (g Group) VRFProve(priv *Scalar, input []byte) (beta, proof []byte) {
+	return g.vrfProve(priv, nil, input)
+}
+
+// VRFProveFromSeed is like VRFProve for Edwards25519Sha512, but takes the 32-byte RFC 8032 seed as secret key, from
+// which the secret scalar and the nonce prefix are derived as RFC 9381 specifies. It returns an error if the seed is
+// not 32 bytes long, or if the group is not Edwards25519Sha512.
+func (g Group) VRFProveFromSeed(seed, input []byte) (beta, proof []byte, err error) {
+	if g != Edwards25519Sha512 {
+		return nil, nil, fmt.Errorf("VRFProveFromSeed: %w", errUnsupported)
+	}
+
+	if len(seed) != g.ScalarLength() {
+		return nil, nil, errSeedLength
+	}
+
+	h := g.HashFunc().New()
+	_, _ = h.Write(seed)
+	hashed := h.Sum(nil)
+
+	priv := g.NewScalar()
+	if err = priv.DecodeClamped(hashed[:g.ScalarLength()]); err != nil {
+		return nil, nil, fmt.Errorf("VRFProveFromSeed: %w", err)
+	}
+
+	beta, proof = g.vrfProve(priv, hashed[g.ScalarLength():], input)
+
+	return beta, proof, nil
+}
+
+// vrfProve implements ECVRF_prove, with truncated the optional nonce prefix for Edwards25519.
+func (g Group) vrfProve(priv *Scalar, truncated, input []byte) (beta, proof []byte) {
+	s := g.vrfSuite()
+
+	pub := g.Base().Multiply(priv)
+	pk := pub.Encode()
+	h := s.encodeToCurve(g, pk, input)
+	hString := h.Encode()
+	gamma := h.Copy().Multiply(priv)
+	k := s.nonce(g, priv, truncated, hString)
+	u := g.Base().Multiply(k)
+	v := h.Copy().Multiply(k)
+	cString := s.challenge(g, pub, h, gamma, u, v)
+
+	c, err := s.stringToScalar(g, cString)
+	if err != nil {
+		panic(err)
+	}
+
+	// s = k + c*x
+	z := c.Multiply(priv).Add(k)
+
+	gammaString := gamma.Encode()
+	proof = make([]byte, 0, len(gammaString)+vrfChallengeLength+g.ScalarLength())
+	proof = append(proof, gammaString...)
+	proof = append(proof, cString...)
+	proof = append(proof, z.Encode()...)
+
+	return s.proofToHash(g, gamma), proof
+}
+
+// VRFVerify verifies the RFC 9381 ECVRF proof for the input and public key pub, and returns the VRF output beta and
+// true if valid, and nil and false otherwise. It panics for groups other than P256Sha256 and Edwards25519Sha512.
+func (g Group) VRFVerify(pub *Element, input, proof []byte) (beta []byte, ok bool) {
+	s := g.vrfSuite()
+
+	if pub == nil || pub.IsIdentity() {
+		return nil, false
+	}
+
+	// Reject low order public keys.
+	if s.cofactor != 1 {
+		y := pub.Copy()
+		for i := 1; i < s.cofactor; i <<= 1 {
+			y.Double()
+		}
+
+		if y.IsIdentity() {
+			return nil, false
+		}
+	}
+
+	ptLen := g.ElementLength()
+	if len(proof) != ptLen+vrfChallengeLength+g.ScalarLength() {
+		return nil, false
+	}
+
+	gamma := g.NewElement()
+	if err := gamma.Decode(proof[:ptLen]); err != nil {
+		return nil, false
+	}
+
+	cString := proof[ptLen : ptLen+vrfChallengeLength]
+
+	c, err := s.stringToScalar(g, cString)
+	if err != nil {
+		return nil, false
+	}
+
+	z := g.NewScalar()
+	if err = z.Decode(proof[ptLen+vrfChallengeLength:]); err != nil {
+		return nil, false
+	}
+
+	h := s.encodeToCurve(g, pub.Encode(), input)
+
+	// U = s*B - c*Y, V = s*H - c*Gamma
+	u := g.Base().Multiply(z).Subtract(pub.Copy().Multiply(c))
+	v := h.Copy().Multiply(z).Subtract(gamma.Copy().Multiply(c))
+
+	if subtle.ConstantTimeCompare(cString, s.challenge(g, pub, h, gamma, u, v)) != 1 {
+		return nil, false
+	}
+
+	return s.proofToHash(g, gamma), true
+}
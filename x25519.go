@@ -0,0 +1,32 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (C) 2020-2023 Daniel Bourdrez. All Rights Reserved.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree or at
+// https://spdx.org/licenses/MIT.html
+
+package crypto
+
+import (
+	"fmt"
+
+	"github.com/bytemare/crypto/internal/edwards25519"
+)
+
+// X25519 implements the RFC 7748 X25519 function on the Montgomery form of Edwards25519: the 32-byte scalar is
+// clamped and multiplied with the point of the given 32-byte little-endian u-coordinate, and the resulting
+// u-coordinate is returned. The output is not checked for the all-zero value, which callers doing key exchange must
+// reject. This is only supported by the Edwards25519Sha512 group.
+func (g Group) X25519(scalar, uCoordinate []byte) ([]byte, error) {
+	if g != Edwards25519Sha512 {
+		return nil, errUnsupported
+	}
+
+	u, err := edwards25519.X25519(scalar, uCoordinate)
+	if err != nil {
+		return nil, fmt.Errorf("x25519: %w", err)
+	}
+
+	return u, nil
+}